@@ -2,10 +2,11 @@ package jikan
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
-	"strconv"
 )
 
 type AnimeService struct {
@@ -13,59 +14,55 @@ type AnimeService struct {
 }
 
 type Anime struct {
-	MalID   ID       `json:"mal_id"`
-	URL     string   `json:"url"`
-	Images  ImageSet `json:"images"`
-	Trailer struct {
-		YoutubeID string `json:"youtube_id"`
-		URL       string `json:"url"`
-		EmbedURL  string `json:"embed_url"`
-		Images    struct {
-			ImageURL        string `json:"image_url"`
-			SmallImageURL   string `json:"small_image_url"`
-			MediumImageURL  string `json:"medium_image_url"`
-			LargeImageURL   string `json:"large_image_url"`
-			MaximumImageURL string `json:"maximum_image_url"`
-		} `json:"images"`
-	} `json:"trailer"`
-	Title         string    `json:"title"`
-	TitleEnglish  string    `json:"title_english"`
-	TitleJapanese string    `json:"title_japanese"`
-	TitleSynonyms []string  `json:"title_synonyms"`
-	Type          string    `json:"type"`
-	Source        string    `json:"source"`
-	Episodes      int       `json:"episodes"`
-	Status        string    `json:"status"`
-	Airing        bool      `json:"airing"`
-	Aired         DateRange `json:"aired"`
-	Duration      string    `json:"duration"`
-	Rating        string    `json:"rating"`
-	Score         float64   `json:"score"`
-	ScoredBy      int       `json:"scored_by"`
-	Rank          int       `json:"rank"`
-	Popularity    int       `json:"popularity"`
-	Members       int       `json:"members"`
-	Favorites     int       `json:"favorites"`
-	Synopsis      string    `json:"synopsis"`
-	Background    string    `json:"background"`
-	Season        string    `json:"season"`
-	Year          int       `json:"year"`
-	Broadcast     struct {
-		Day      string `json:"day"`
-		Time     string `json:"time"`
-		Timezone string `json:"timezone"`
-		String   string `json:"string"`
-	} `json:"broadcast"`
-	Producers      []Resource `json:"producers"`
-	Licensors      []Resource `json:"licensors"`
-	Studios        []Resource `json:"studios"`
-	Genres         []Resource `json:"genres"`
-	ExplicitGenres []Resource `json:"explicit_genres"`
-	Themes         []Resource `json:"themes"`
-	Demographics   []Resource `json:"demographics"`
+	MalID          ID                `json:"mal_id"`
+	URL            string            `json:"url"`
+	Images         ImageSet          `json:"images"`
+	Trailer        Trailer           `json:"trailer"`
+	Title          string            `json:"title"`
+	TitleEnglish   string            `json:"title_english"`
+	TitleJapanese  string            `json:"title_japanese"`
+	TitleSynonyms  []string          `json:"title_synonyms"`
+	Titles         []Title           `json:"titles"`
+	Approved       bool              `json:"approved"`
+	Type           AnimeType         `json:"type"`
+	Source         AnimeSource       `json:"source"`
+	Episodes       Optional[int]     `json:"episodes"`
+	Status         AnimeStatus       `json:"status"`
+	Airing         bool              `json:"airing"`
+	Aired          DateRange         `json:"aired"`
+	Duration       string            `json:"duration"`
+	Rating         AnimeRating       `json:"rating"`
+	Score          Optional[float64] `json:"score"`
+	ScoredBy       Optional[int]     `json:"scored_by"`
+	Rank           Optional[int]     `json:"rank"`
+	Popularity     Optional[int]     `json:"popularity"`
+	Members        Optional[int]     `json:"members"`
+	Favorites      Optional[int]     `json:"favorites"`
+	Synopsis       string            `json:"synopsis"`
+	Background     string            `json:"background"`
+	Season         Season            `json:"season"`
+	Year           Optional[int]     `json:"year"`
+	Broadcast      Broadcast         `json:"broadcast"`
+	Producers      []Resource        `json:"producers"`
+	Licensors      []Resource        `json:"licensors"`
+	Studios        []Resource        `json:"studios"`
+	Genres         []Resource        `json:"genres"`
+	ExplicitGenres []Resource        `json:"explicit_genres"`
+	Themes         []Resource        `json:"themes"`
+	Demographics   []Resource        `json:"demographics"`
+}
+
+type Broadcast struct {
+	Day      string `json:"day"`
+	Time     string `json:"time"`
+	Timezone string `json:"timezone"`
+	String   string `json:"string"`
 }
 
 func (s *AnimeService) ByID(ctx context.Context, id ID) (*Anime, error) {
+	if err := checkID("anime id", id); err != nil {
+		return nil, err
+	}
 	var r struct{ Data Anime }
 	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/anime/%d", id), nil, &r); err != nil {
 		return nil, err
@@ -73,134 +70,125 @@ func (s *AnimeService) ByID(ctx context.Context, id ID) (*Anime, error) {
 	return &r.Data, nil
 }
 
-func (s *AnimeService) Characters(ctx context.Context, id ID) ([]struct {
-	Character   Resource `json:"character"`
-	Role        string   `json:"role"`
-	VoiceActors []struct {
-		Person   Resource `json:"person"`
-		Language string   `json:"language"`
-	} `json:"voice_actors"`
-}, error) {
-	var r struct {
-		Data []struct {
-			Character   Resource `json:"character"`
-			Role        string   `json:"role"`
-			VoiceActors []struct {
-				Person   Resource `json:"person"`
-				Language string   `json:"language"`
-			} `json:"voice_actors"`
-		}
-	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/anime/%d/characters", id), nil, &r); err != nil {
-		return nil, err
+// ByIDRaw is ByID plus the anime JSON as Jikan sent it, for fields Anime
+// does not have yet.
+func (s *AnimeService) ByIDRaw(ctx context.Context, id ID) (*Anime, json.RawMessage, error) {
+	if err := checkID("anime id", id); err != nil {
+		return nil, nil, err
 	}
-	return r.Data, nil
+	r, raw, err := fetchRaw[Anime](ctx, s.c, fmt.Sprintf("/anime/%d", id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &r, raw, nil
 }
 
-func (s *AnimeService) Staff(ctx context.Context, id ID) ([]struct {
-	Person    Resource `json:"person"`
-	Positions []string `json:"positions"`
-}, error) {
-	var r struct {
-		Data []struct {
-			Person    Resource `json:"person"`
-			Positions []string `json:"positions"`
-		}
-	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/anime/%d/staff", id), nil, &r); err != nil {
+func (s *AnimeService) ByIDs(ctx context.Context, ids []ID, opts BatchOptions) *BatchResult[Anime] {
+	return batch(ctx, ids, opts, s.ByID)
+}
+
+type AnimeCharacter struct {
+	Character   CharacterMeta     `json:"character"`
+	Role        string            `json:"role"`
+	Favorites   int               `json:"favorites"`
+	VoiceActors []AnimeVoiceActor `json:"voice_actors"`
+}
+
+type AnimeVoiceActor struct {
+	Person   PersonMeta `json:"person"`
+	Language string     `json:"language"`
+}
+
+func (s *AnimeService) Characters(ctx context.Context, id ID) ([]AnimeCharacter, error) {
+	if err := checkID("anime id", id); err != nil {
 		return nil, err
 	}
-	return r.Data, nil
-}
-
-func (s *AnimeService) Episodes(ctx context.Context, id ID, page int) ([]struct {
-	MalID         ID      `json:"mal_id"`
-	URL           string  `json:"url"`
-	Title         string  `json:"title"`
-	TitleJapanese string  `json:"title_japanese"`
-	TitleRomanji  string  `json:"title_romanji"`
-	Aired         string  `json:"aired"`
-	Score         float64 `json:"score"`
-	Filler        bool    `json:"filler"`
-	Recap         bool    `json:"recap"`
-}, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
-	var r struct {
-		Data []struct {
-			MalID         ID      `json:"mal_id"`
-			URL           string  `json:"url"`
-			Title         string  `json:"title"`
-			TitleJapanese string  `json:"title_japanese"`
-			TitleRomanji  string  `json:"title_romanji"`
-			Aired         string  `json:"aired"`
-			Score         float64 `json:"score"`
-			Filler        bool    `json:"filler"`
-			Recap         bool    `json:"recap"`
-		} `json:"data"`
-		Pagination Pagination `json:"pagination"`
+	return fetch[[]AnimeCharacter](ctx, s.c, fmt.Sprintf("/anime/%d/characters", id), nil)
+}
+
+type AnimeStaff struct {
+	Person    PersonMeta `json:"person"`
+	Positions []string   `json:"positions"`
+}
+
+func (s *AnimeService) Staff(ctx context.Context, id ID) ([]AnimeStaff, error) {
+	if err := checkID("anime id", id); err != nil {
+		return nil, err
 	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/anime/%d/episodes", id), q, &r); err != nil {
+	return fetch[[]AnimeStaff](ctx, s.c, fmt.Sprintf("/anime/%d/staff", id), nil)
+}
+
+type Episode struct {
+	MalID         ID                `json:"mal_id"`
+	URL           string            `json:"url"`
+	Title         string            `json:"title"`
+	TitleJapanese string            `json:"title_japanese"`
+	TitleRomanji  string            `json:"title_romanji"`
+	Aired         Date              `json:"aired"`
+	Score         Optional[float64] `json:"score,omitempty"`
+	Filler        bool              `json:"filler"`
+	Recap         bool              `json:"recap"`
+	ForumURL      string            `json:"forum_url,omitempty"`
+}
+
+// EpisodeDetail is the single-episode payload, which adds a synopsis and
+// the runtime in seconds to the list fields but leaves out the score and
+// forum link.
+type EpisodeDetail struct {
+	Episode
+	Synopsis string `json:"synopsis"`
+	Duration int    `json:"duration"`
+}
+
+type VideoEpisode struct {
+	MalID   ID            `json:"mal_id"`
+	URL     string        `json:"url"`
+	Title   string        `json:"title"`
+	Episode string        `json:"episode"`
+	Images  BasicImageSet `json:"images"`
+}
+
+func (s *AnimeService) Episodes(ctx context.Context, id ID, page int) ([]Episode, *Pagination, error) {
+	if err := validate(checkID("anime id", id), checkPage(page)); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
-}
-
-func (s *AnimeService) EpisodeByID(ctx context.Context, animeID ID, episode int) (*struct {
-	MalID         ID      `json:"mal_id"`
-	URL           string  `json:"url"`
-	Title         string  `json:"title"`
-	TitleJapanese string  `json:"title_japanese"`
-	TitleRomanji  string  `json:"title_romanji"`
-	Synopsis      string  `json:"synopsis"`
-	Aired         string  `json:"aired"`
-	Score         float64 `json:"score"`
-	Filler        bool    `json:"filler"`
-	Recap         bool    `json:"recap"`
-}, error) {
-	var r struct {
-		Data struct {
-			MalID         ID      `json:"mal_id"`
-			URL           string  `json:"url"`
-			Title         string  `json:"title"`
-			TitleJapanese string  `json:"title_japanese"`
-			TitleRomanji  string  `json:"title_romanji"`
-			Synopsis      string  `json:"synopsis"`
-			Aired         string  `json:"aired"`
-			Score         float64 `json:"score"`
-			Filler        bool    `json:"filler"`
-			Recap         bool    `json:"recap"`
-		}
-	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/anime/%d/episodes/%d", animeID, episode), nil, &r); err != nil {
-		return nil, err
+	q := pageQuery(page)
+	return fetchPaged[[]Episode](ctx, s.c, fmt.Sprintf("/anime/%d/episodes", id), q)
+}
+
+func (s *AnimeService) EpisodesAll(ctx context.Context, id ID, pageOpts ...PageOption) iter.Seq2[Episode, error] {
+	if err := checkID("anime id", id); err != nil {
+		return failed[Episode](err)
 	}
-	return &r.Data, nil
+	return paginate[Episode](ctx, s.c, fmt.Sprintf("/anime/%d/episodes", id), nil, pageOpts)
 }
 
-func (s *AnimeService) News(ctx context.Context, id ID, page int) ([]struct {
-	MalID    ID     `json:"mal_id"`
-	Title    string `json:"title"`
-	Date     string `json:"date"`
-	Author   string `json:"author_username"`
-	Comments int    `json:"comments"`
-	Excerpt  string `json:"excerpt"`
-}, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
-	var r struct {
-		Data []struct {
-			MalID    ID     `json:"mal_id"`
-			Title    string `json:"title"`
-			Date     string `json:"date"`
-			Author   string `json:"author_username"`
-			Comments int    `json:"comments"`
-			Excerpt  string `json:"excerpt"`
-		} `json:"data"`
-		Pagination Pagination `json:"pagination"`
+func (s *AnimeService) EpisodeByID(ctx context.Context, animeID ID, episode int) (*EpisodeDetail, error) {
+	if err := validate(checkID("anime id", animeID), checkID("episode", ID(episode))); err != nil {
+		return nil, err
 	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/anime/%d/news", id), q, &r); err != nil {
+	r, err := fetch[EpisodeDetail](ctx, s.c, fmt.Sprintf("/anime/%d/episodes/%d", animeID, episode), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type AnimeNews = NewsArticle
+
+func (s *AnimeService) News(ctx context.Context, id ID, page int) ([]AnimeNews, *Pagination, error) {
+	if err := validate(checkID("anime id", id), checkPage(page)); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
+	q := pageQuery(page)
+	return fetchPaged[[]AnimeNews](ctx, s.c, fmt.Sprintf("/anime/%d/news", id), q)
+}
+
+func (s *AnimeService) NewsAll(ctx context.Context, id ID, pageOpts ...PageOption) iter.Seq2[AnimeNews, error] {
+	if err := checkID("anime id", id); err != nil {
+		return failed[AnimeNews](err)
+	}
+	return paginate[AnimeNews](ctx, s.c, fmt.Sprintf("/anime/%d/news", id), nil, pageOpts)
 }
 
 type ForumFilter string
@@ -211,97 +199,72 @@ const (
 	ForumFilterOther   ForumFilter = "other"
 )
 
-func (s *AnimeService) Forum(ctx context.Context, id ID, filter ForumFilter) ([]struct {
-	MalID    ID     `json:"mal_id"`
-	Title    string `json:"title"`
-	Date     string `json:"date"`
-	Author   string `json:"author_username"`
-	Comments int    `json:"comments"`
-}, error) {
+type AnimeTopic = ForumTopic
+
+func (s *AnimeService) Forum(ctx context.Context, id ID, filter ForumFilter) ([]AnimeTopic, error) {
+	if err := validate(checkID("anime id", id), checkEnum("filter", filter, ForumFilterAll, ForumFilterEpisode, ForumFilterOther)); err != nil {
+		return nil, err
+	}
 	q := url.Values{}
 	if filter != "" {
 		q.Set("filter", string(filter))
 	}
-	var r struct {
-		Data []struct {
-			MalID    ID     `json:"mal_id"`
-			Title    string `json:"title"`
-			Date     string `json:"date"`
-			Author   string `json:"author_username"`
-			Comments int    `json:"comments"`
-		} `json:"data"`
-	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/anime/%d/forum", id), q, &r); err != nil {
+	return fetch[[]AnimeTopic](ctx, s.c, fmt.Sprintf("/anime/%d/forum", id), q)
+}
+
+type AnimePromo struct {
+	Title   string  `json:"title"`
+	Trailer Trailer `json:"trailer"`
+}
+
+type AnimeMusicVideo struct {
+	Title string         `json:"title"`
+	Video Trailer        `json:"video"`
+	Meta  MusicVideoMeta `json:"meta"`
+}
+
+type MusicVideoMeta struct {
+	Title  string `json:"title"`
+	Author string `json:"author"`
+}
+
+type AnimeVideos struct {
+	Promos      []AnimePromo      `json:"promo"`
+	Episodes    []VideoEpisode    `json:"episodes"`
+	MusicVideos []AnimeMusicVideo `json:"music_videos"`
+}
+
+func (s *AnimeService) Videos(ctx context.Context, id ID) (*AnimeVideos, error) {
+	if err := checkID("anime id", id); err != nil {
 		return nil, err
 	}
-	return r.Data, nil
-}
-
-func (s *AnimeService) Videos(ctx context.Context, id ID) (*struct {
-	Promos []struct {
-		Title   string `json:"title"`
-		Trailer struct {
-			YoutubeID string `json:"youtube_id"`
-			URL       string `json:"url"`
-			EmbedURL  string `json:"embed_url"`
-			Images    struct {
-				DefaultImageURL string `json:"default_image_url"`
-				SmallImageURL   string `json:"small_image_url"`
-				MediumImageURL  string `json:"medium_image_url"`
-				LargeImageURL   string `json:"large_image_url"`
-				MaximumImageURL string `json:"maximum_image_url"`
-			} `json:"images"`
-		} `json:"trailer"`
-	} `json:"promos"`
-	Episodes []struct {
-		MalID   ID     `json:"mal_id"`
-		URL     string `json:"url"`
-		Title   string `json:"title"`
-		Episode string `json:"episode"`
-		Images  struct {
-			JPG struct {
-				ImageURL string `json:"image_url"`
-			} `json:"jpg"`
-		} `json:"images"`
-	} `json:"episodes"`
-}, error) {
-	var r struct {
-		Data struct {
-			Promos []struct {
-				Title   string `json:"title"`
-				Trailer struct {
-					YoutubeID string `json:"youtube_id"`
-					URL       string `json:"url"`
-					EmbedURL  string `json:"embed_url"`
-					Images    struct {
-						DefaultImageURL string `json:"default_image_url"`
-						SmallImageURL   string `json:"small_image_url"`
-						MediumImageURL  string `json:"medium_image_url"`
-						LargeImageURL   string `json:"large_image_url"`
-						MaximumImageURL string `json:"maximum_image_url"`
-					} `json:"images"`
-				} `json:"trailer"`
-			} `json:"promos"`
-			Episodes []struct {
-				MalID   ID     `json:"mal_id"`
-				URL     string `json:"url"`
-				Title   string `json:"title"`
-				Episode string `json:"episode"`
-				Images  struct {
-					JPG struct {
-						ImageURL string `json:"image_url"`
-					} `json:"jpg"`
-				} `json:"images"`
-			} `json:"episodes"`
-		}
-	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/anime/%d/videos", id), nil, &r); err != nil {
+	r, err := fetch[AnimeVideos](ctx, s.c, fmt.Sprintf("/anime/%d/videos", id), nil)
+	if err != nil {
 		return nil, err
 	}
-	return &r.Data, nil
+	return &r, nil
+}
+
+// VideoEpisodes returns the episode thumbnails listed on the anime's videos page.
+func (s *AnimeService) VideoEpisodes(ctx context.Context, id ID, page int) ([]VideoEpisode, *Pagination, error) {
+	if err := validate(checkID("anime id", id), checkPage(page)); err != nil {
+		return nil, nil, err
+	}
+	q := pageQuery(page)
+	return fetchPaged[[]VideoEpisode](ctx, s.c, fmt.Sprintf("/anime/%d/videos/episodes", id), q)
+}
+
+func (s *AnimeService) VideoEpisodesAll(ctx context.Context, id ID, pageOpts ...PageOption) iter.Seq2[VideoEpisode, error] {
+	if err := checkID("anime id", id); err != nil {
+		return failed[VideoEpisode](err)
+	}
+	return paginate[VideoEpisode](ctx, s.c, fmt.Sprintf("/anime/%d/videos/episodes", id), nil, pageOpts)
 }
 
 func (s *AnimeService) Pictures(ctx context.Context, id ID) ([]ImageSet, error) {
+	if err := checkID("anime id", id); err != nil {
+		return nil, err
+	}
 	var r struct {
 		Data []struct {
 			Images ImageSet `json:"images"`
@@ -317,42 +280,32 @@ func (s *AnimeService) Pictures(ctx context.Context, id ID) ([]ImageSet, error)
 	return sets, nil
 }
 
+type AnimeStats struct {
+	Watching    int          `json:"watching"`
+	Completed   int          `json:"completed"`
+	OnHold      int          `json:"on_hold"`
+	Dropped     int          `json:"dropped"`
+	PlanToWatch int          `json:"plan_to_watch"`
+	Total       int          `json:"total"`
+	Scores      []ScoreStats `json:"scores"`
+}
+
 // Statistics returns full stats with score breakdown
-func (s *AnimeService) Statistics(ctx context.Context, id ID) (*struct {
-	Watching    int `json:"watching"`
-	Completed   int `json:"completed"`
-	OnHold      int `json:"on_hold"`
-	Dropped     int `json:"dropped"`
-	PlanToWatch int `json:"plan_to_watch"`
-	Total       int `json:"total"`
-	Scores      []struct {
-		Score      int     `json:"score"`
-		Votes      int     `json:"votes"`
-		Percentage float64 `json:"percentage"`
-	} `json:"scores"`
-}, error) {
-	var r struct {
-		Data struct {
-			Watching    int `json:"watching"`
-			Completed   int `json:"completed"`
-			OnHold      int `json:"on_hold"`
-			Dropped     int `json:"dropped"`
-			PlanToWatch int `json:"plan_to_watch"`
-			Total       int `json:"total"`
-			Scores      []struct {
-				Score      int     `json:"score"`
-				Votes      int     `json:"votes"`
-				Percentage float64 `json:"percentage"`
-			} `json:"scores"`
-		}
-	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/anime/%d/statistics", id), nil, &r); err != nil {
+func (s *AnimeService) Statistics(ctx context.Context, id ID) (*AnimeStats, error) {
+	if err := checkID("anime id", id); err != nil {
 		return nil, err
 	}
-	return &r.Data, nil
+	r, err := fetch[AnimeStats](ctx, s.c, fmt.Sprintf("/anime/%d/statistics", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
 
 func (s *AnimeService) MoreInfo(ctx context.Context, id ID) (string, error) {
+	if err := checkID("anime id", id); err != nil {
+		return "", err
+	}
 	var r struct {
 		Data struct {
 			MoreInfo string `json:"moreinfo"`
@@ -364,138 +317,111 @@ func (s *AnimeService) MoreInfo(ctx context.Context, id ID) (string, error) {
 	return r.Data.MoreInfo, nil
 }
 
-func (s *AnimeService) Recommendations(ctx context.Context, id ID) ([]struct {
-	Entry Resource `json:"entry"`
-	URL   string   `json:"url"`
-	Votes int      `json:"votes"`
-}, error) {
-	var r struct {
-		Data []struct {
-			Entry Resource `json:"entry"`
-			URL   string   `json:"url"`
-			Votes int      `json:"votes"`
-		} `json:"data"`
-	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/anime/%d/recommendations", id), nil, &r); err != nil {
+type AnimeRecommendation = EntryRecommendation
+
+func (s *AnimeService) Recommendations(ctx context.Context, id ID) ([]AnimeRecommendation, error) {
+	if err := checkID("anime id", id); err != nil {
 		return nil, err
 	}
-	return r.Data, nil
+	return fetch[[]AnimeRecommendation](ctx, s.c, fmt.Sprintf("/anime/%d/recommendations", id), nil)
 }
 
-func (s *AnimeService) UserUpdates(ctx context.Context, id ID, page int) ([]struct {
-	User          Resource `json:"user"`
+type AnimeUserUpdate struct {
+	User          UserMeta `json:"user"`
 	Score         float64  `json:"score"`
 	Status        string   `json:"status"`
 	EpisodesSeen  int      `json:"episodes_seen"`
 	EpisodesTotal int      `json:"episodes_total"`
-	Date          string   `json:"date"`
-}, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
-	var r struct {
-		Data []struct {
-			User          Resource `json:"user"`
-			Score         float64  `json:"score"`
-			Status        string   `json:"status"`
-			EpisodesSeen  int      `json:"episodes_seen"`
-			EpisodesTotal int      `json:"episodes_total"`
-			Date          string   `json:"date"`
-		} `json:"data"`
-		Pagination Pagination `json:"pagination"`
-	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/anime/%d/userupdates", id), q, &r); err != nil {
+	Date          Date     `json:"date"`
+}
+
+func (s *AnimeService) UserUpdates(ctx context.Context, id ID, page int) ([]AnimeUserUpdate, *Pagination, error) {
+	if err := validate(checkID("anime id", id), checkPage(page)); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
-}
-
-func (s *AnimeService) Reviews(ctx context.Context, id ID, page int) ([]struct {
-	User            Resource `json:"user"`
-	MalID           int      `json:"mal_id"`
-	Score           int      `json:"score"`
-	Review          string   `json:"review"`
-	EpisodesWatched int      `json:"episodes_watched"`
-	Date            string   `json:"date"`
-	Votes           int      `json:"votes"`
-	Scores          struct {
-		Overall   int `json:"overall"`
-		Story     int `json:"story"`
-		Animation int `json:"animation"`
-		Sound     int `json:"sound"`
-		Character int `json:"character"`
-		Enjoyment int `json:"enjoyment"`
-	} `json:"scores"`
-}, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
-	var r struct {
-		Data []struct {
-			User            Resource `json:"user"`
-			MalID           int      `json:"mal_id"`
-			Score           int      `json:"score"`
-			Review          string   `json:"review"`
-			EpisodesWatched int      `json:"episodes_watched"`
-			Date            string   `json:"date"`
-			Votes           int      `json:"votes"`
-			Scores          struct {
-				Overall   int `json:"overall"`
-				Story     int `json:"story"`
-				Animation int `json:"animation"`
-				Sound     int `json:"sound"`
-				Character int `json:"character"`
-				Enjoyment int `json:"enjoyment"`
-			} `json:"scores"`
-		} `json:"data"`
-		Pagination Pagination `json:"pagination"`
+	q := pageQuery(page)
+	return fetchPaged[[]AnimeUserUpdate](ctx, s.c, fmt.Sprintf("/anime/%d/userupdates", id), q)
+}
+
+func (s *AnimeService) UserUpdatesAll(ctx context.Context, id ID, pageOpts ...PageOption) iter.Seq2[AnimeUserUpdate, error] {
+	if err := checkID("anime id", id); err != nil {
+		return failed[AnimeUserUpdate](err)
 	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/anime/%d/reviews", id), q, &r); err != nil {
+	return paginate[AnimeUserUpdate](ctx, s.c, fmt.Sprintf("/anime/%d/userupdates", id), nil, pageOpts)
+}
+
+// AnimeReviewScores is the per-category breakdown reviews used to carry.
+// Jikan now only sends the overall score, so the other fields are zero.
+type AnimeReviewScores struct {
+	Overall   int `json:"overall"`
+	Story     int `json:"story"`
+	Animation int `json:"animation"`
+	Sound     int `json:"sound"`
+	Character int `json:"character"`
+	Enjoyment int `json:"enjoyment"`
+}
+
+// UnmarshalJSON decodes the current schema and fills in Votes, from the
+// overall reaction count, and Scores.Overall, from score.
+func (r *AnimeReview) UnmarshalJSON(b []byte) error {
+	type plain AnimeReview
+	if err := json.Unmarshal(b, (*plain)(r)); err != nil {
+		return err
+	}
+	r.Votes = r.Reactions.Overall
+	r.Scores = AnimeReviewScores{Overall: r.Score}
+	return nil
+}
+
+func (s *AnimeService) Reviews(ctx context.Context, id ID, page int) ([]AnimeReview, *Pagination, error) {
+	if err := validate(checkID("anime id", id), checkPage(page)); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
+	q := pageQuery(page)
+	return fetchPaged[[]AnimeReview](ctx, s.c, fmt.Sprintf("/anime/%d/reviews", id), q)
 }
 
-func (s *AnimeService) Relations(ctx context.Context, id ID) ([]struct {
-	Relation string     `json:"relation"`
-	Entry    []Resource `json:"entry"`
-}, error) {
-	var r struct {
-		Data []struct {
-			Relation string     `json:"relation"`
-			Entry    []Resource `json:"entry"`
-		} `json:"data"`
+func (s *AnimeService) ReviewsAll(ctx context.Context, id ID, pageOpts ...PageOption) iter.Seq2[AnimeReview, error] {
+	if err := checkID("anime id", id); err != nil {
+		return failed[AnimeReview](err)
 	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/anime/%d/relations", id), nil, &r); err != nil {
+	return paginate[AnimeReview](ctx, s.c, fmt.Sprintf("/anime/%d/reviews", id), nil, pageOpts)
+}
+
+func (s *AnimeService) Relations(ctx context.Context, id ID) ([]Relation, error) {
+	if err := checkID("anime id", id); err != nil {
 		return nil, err
 	}
-	return r.Data, nil
+	return fetch[[]Relation](ctx, s.c, fmt.Sprintf("/anime/%d/relations", id), nil)
 }
 
-func (s *AnimeService) Themes(ctx context.Context, id ID) (*struct {
+type AnimeThemes struct {
 	Openings []string `json:"openings"`
 	Endings  []string `json:"endings"`
-}, error) {
-	var r struct {
-		Data struct {
-			Openings []string `json:"openings"`
-			Endings  []string `json:"endings"`
-		}
+}
+
+func (s *AnimeService) Themes(ctx context.Context, id ID) (*AnimeThemes, error) {
+	if err := checkID("anime id", id); err != nil {
+		return nil, err
 	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/anime/%d/themes", id), nil, &r); err != nil {
+	r, err := fetch[AnimeThemes](ctx, s.c, fmt.Sprintf("/anime/%d/themes", id), nil)
+	if err != nil {
 		return nil, err
 	}
-	return &r.Data, nil
+	return &r, nil
 }
 
-func (s *AnimeService) External(ctx context.Context, id ID) ([]struct {
-	Name string `json:"name"`
-	URL  string `json:"url"`
-}, error) {
-	var r struct {
-		Data []struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"data"`
+func (s *AnimeService) External(ctx context.Context, id ID) ([]ExternalLink, error) {
+	if err := checkID("anime id", id); err != nil {
+		return nil, err
 	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/anime/%d/external", id), nil, &r); err != nil {
+	return fetch[[]ExternalLink](ctx, s.c, fmt.Sprintf("/anime/%d/external", id), nil)
+}
+
+// Streaming returns the services the anime is officially streamed on.
+func (s *AnimeService) Streaming(ctx context.Context, id ID) ([]ExternalLink, error) {
+	if err := checkID("anime id", id); err != nil {
 		return nil, err
 	}
-	return r.Data, nil
+	return fetch[[]ExternalLink](ctx, s.c, fmt.Sprintf("/anime/%d/streaming", id), nil)
 }
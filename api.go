@@ -0,0 +1,47 @@
+package jikan
+
+//go:generate go run ./internal/cmd/genapi
+
+// API is the full client surface, one accessor per service. *Client
+// implements it; code that depends on API instead of *Client can be
+// tested with jikantest.FakeAPI.
+//
+// The per-service interfaces (AnimeAPI, MangaAPI, ...) are generated from
+// the service method sets; run go generate after adding a method.
+type API interface {
+	AnimeAPI() AnimeAPI
+	MangaAPI() MangaAPI
+	CharacterAPI() CharacterAPI
+	PeopleAPI() PeopleAPI
+	UserAPI() UserAPI
+	SeasonAPI() SeasonAPI
+	TopAPI() TopAPI
+	ProducerAPI() ProducerAPI
+	MagazineAPI() MagazineAPI
+	GenreAPI() GenreAPI
+	SearchAPI() SearchAPI
+	ReviewAPI() ReviewAPI
+	RecommendationAPI() RecommendationAPI
+	WatchAPI() WatchAPI
+	ClubAPI() ClubAPI
+	RandomAPI() RandomAPI
+}
+
+var _ API = (*Client)(nil)
+
+func (c *Client) AnimeAPI() AnimeAPI                   { return c.Anime }
+func (c *Client) MangaAPI() MangaAPI                   { return c.Manga }
+func (c *Client) CharacterAPI() CharacterAPI           { return c.Character }
+func (c *Client) PeopleAPI() PeopleAPI                 { return c.People }
+func (c *Client) UserAPI() UserAPI                     { return c.User }
+func (c *Client) SeasonAPI() SeasonAPI                 { return c.Season }
+func (c *Client) TopAPI() TopAPI                       { return c.Top }
+func (c *Client) ProducerAPI() ProducerAPI             { return c.Producer }
+func (c *Client) MagazineAPI() MagazineAPI             { return c.Magazine }
+func (c *Client) GenreAPI() GenreAPI                   { return c.Genre }
+func (c *Client) SearchAPI() SearchAPI                 { return c.Search }
+func (c *Client) ReviewAPI() ReviewAPI                 { return c.Review }
+func (c *Client) RecommendationAPI() RecommendationAPI { return c.Recommendation }
+func (c *Client) WatchAPI() WatchAPI                   { return c.Watch }
+func (c *Client) ClubAPI() ClubAPI                     { return c.Club }
+func (c *Client) RandomAPI() RandomAPI                 { return c.Random }
@@ -0,0 +1,137 @@
+package jikan
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// backendCooldown is how long a backend that failed is skipped before it
+// is tried first again.
+const backendCooldown = 30 * time.Second
+
+// now is the clock cooldowns are measured with; tests replace it.
+var now = time.Now
+
+// backend is one Jikan deployment requests can be sent to.
+type backend struct {
+	base *url.URL
+
+	mu        sync.Mutex
+	failures  int
+	downUntil time.Time
+}
+
+func (b *backend) String() string { return b.base.String() }
+
+func (b *backend) url(path string, q url.Values) string {
+	if len(q) == 0 {
+		return b.base.String() + path
+	}
+	return b.base.String() + path + "?" + q.Encode()
+}
+
+func (b *backend) healthy(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !now.Before(b.downUntil)
+}
+
+// markDown records a failed request. Each consecutive failure doubles the
+// cooldown, up to eight times backendCooldown.
+func (b *backend) markDown(now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	b.downUntil = now.Add(backendCooldown << min(b.failures-1, 3))
+}
+
+func (b *backend) markUp() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.downUntil = time.Time{}
+}
+
+// parseBaseURL validates a Jikan base URL. It must be absolute http or
+// https without a query or fragment. A trailing slash is dropped and a
+// bare host gets Jikan's /v4 prefix, so "http://localhost:8080" and
+// "http://localhost:8080/v4/" are the same backend.
+func parseBaseURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, invalid("base URL", raw, err.Error())
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, invalid("base URL", raw, "scheme must be http or https")
+	}
+	if u.Host == "" {
+		return nil, invalid("base URL", raw, "missing host")
+	}
+	if u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return nil, invalid("base URL", raw, "must not have a query, fragment or credentials")
+	}
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = ""
+	if u.Path == "" {
+		u.Path = "/v4"
+	}
+	return u, nil
+}
+
+// WithBaseURL sends requests to a self-hosted Jikan instance instead of
+// the public API. An invalid URL makes every request fail with a
+// *ValidationError.
+func WithBaseURL(raw string) Option {
+	return func(c *Client) {
+		u, err := parseBaseURL(raw)
+		if err != nil {
+			c.err = err
+			return
+		}
+		c.backends[0] = &backend{base: u}
+	}
+}
+
+// WithFallbackURL adds a backend tried when the ones before it fail with a
+// network error or a 5xx response. Fallbacks are tried in the order they
+// are given, after the base URL:
+//
+//	jikan.New(
+//		jikan.WithBaseURL("http://jikan.internal:8080"),
+//		jikan.WithFallbackURL(jikan.DefaultBaseURL),
+//	)
+//
+// A backend that fails is skipped for a cooldown, so later requests go
+// straight to the next healthy one.
+func WithFallbackURL(raw string) Option {
+	return func(c *Client) {
+		u, err := parseBaseURL(raw)
+		if err != nil {
+			c.err = err
+			return
+		}
+		c.backends = append(c.backends, &backend{base: u})
+	}
+}
+
+// candidates returns the backends in the order to try them: healthy ones
+// first, then those cooling down, so a request is still attempted when
+// every backend looks down.
+func (c *Client) candidates() []*backend {
+	if len(c.backends) == 1 {
+		return c.backends
+	}
+	t := now()
+	up := make([]*backend, 0, len(c.backends))
+	var down []*backend
+	for _, b := range c.backends {
+		if b.healthy(t) {
+			up = append(up, b)
+		} else {
+			down = append(down, b)
+		}
+	}
+	return append(up, down...)
+}
@@ -0,0 +1,216 @@
+package jikan_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Sethispr/jikanGo"
+	"github.com/Sethispr/jikanGo/jikantest"
+)
+
+// newBackends starts a primary and a fallback server serving anime 1
+// under different titles, so a test can tell which one answered.
+func newBackends(t *testing.T) (primary, fallback *jikantest.Server) {
+	t.Helper()
+	primary, fallback = jikantest.NewServer(), jikantest.NewServer()
+	t.Cleanup(primary.Close)
+	t.Cleanup(fallback.Close)
+	primary.AddAnime(jikan.Anime{MalID: 1, Title: "primary"})
+	fallback.AddAnime(jikan.Anime{MalID: 1, Title: "fallback"})
+	return primary, fallback
+}
+
+func failoverClient(primary, fallback *jikantest.Server, opts ...jikan.Option) *jikan.Client {
+	base := []jikan.Option{
+		jikan.WithBaseURL(primary.URL + "/v4"),
+		jikan.WithFallbackURL(fallback.URL + "/v4"),
+	}
+	return jikan.New(append(base, opts...)...)
+}
+
+// servedBy fetches anime 1 and returns the title of the server that
+// answered.
+func servedBy(t *testing.T, c *jikan.Client) string {
+	t.Helper()
+	a, err := c.Anime.ByID(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a.Title
+}
+
+func checkRequests(t *testing.T, primary, fallback *jikantest.Server, wantPrimary, wantFallback int) {
+	t.Helper()
+	if p, f := len(primary.Requests()), len(fallback.Requests()); p != wantPrimary || f != wantFallback {
+		t.Errorf("requests: primary %d, fallback %d; want %d, %d", p, f, wantPrimary, wantFallback)
+	}
+}
+
+func TestBackendFailover(t *testing.T) {
+	primary, fallback := newBackends(t)
+	primary.ServerError("", http.StatusBadGateway, 0)
+	c := failoverClient(primary, fallback)
+
+	if got := servedBy(t, c); got != "fallback" {
+		t.Fatalf("served by %s, want fallback", got)
+	}
+	checkRequests(t, primary, fallback, 1, 1)
+
+	// The primary is cooling down, so it is not tried again.
+	if got := servedBy(t, c); got != "fallback" {
+		t.Fatalf("served by %s, want fallback", got)
+	}
+	checkRequests(t, primary, fallback, 1, 2)
+}
+
+func TestBackendFailoverNetworkError(t *testing.T) {
+	primary, fallback := newBackends(t)
+	c := failoverClient(primary, fallback)
+	primary.Close()
+
+	if got := servedBy(t, c); got != "fallback" {
+		t.Fatalf("served by %s, want fallback", got)
+	}
+}
+
+func TestBackendCooldownRecovery(t *testing.T) {
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	defer jikan.SetNow(func() time.Time { return clock })()
+
+	primary, fallback := newBackends(t)
+	primary.ServerError("", http.StatusInternalServerError, 2)
+	c := failoverClient(primary, fallback)
+
+	steps := []struct {
+		after  time.Duration
+		want   string
+		counts [2]int
+	}{
+		{0, "fallback", [2]int{1, 1}},
+		{jikan.BackendCooldown - time.Second, "fallback", [2]int{1, 2}},
+		// The cooldown is over, so the primary is tried first again and
+		// fails a second time, which doubles its cooldown.
+		{time.Second, "fallback", [2]int{2, 3}},
+		{jikan.BackendCooldown, "fallback", [2]int{2, 4}},
+		{jikan.BackendCooldown, "primary", [2]int{3, 4}},
+		// A success clears the failures, so it stays first.
+		{0, "primary", [2]int{4, 4}},
+	}
+	for i, s := range steps {
+		clock = clock.Add(s.after)
+		if got := servedBy(t, c); got != s.want {
+			t.Fatalf("step %d: served by %s, want %s", i, got, s.want)
+		}
+		checkRequests(t, primary, fallback, s.counts[0], s.counts[1])
+	}
+}
+
+func TestBackendAllDown(t *testing.T) {
+	primary, fallback := newBackends(t)
+	primary.ServerError("", http.StatusInternalServerError, 1)
+	fallback.ServerError("", http.StatusInternalServerError, 1)
+	c := failoverClient(primary, fallback, jikan.WithRetries(1))
+
+	// Both backends are cooling down after the first attempt, but the
+	// retry still tries them, in the order they were configured.
+	if got := servedBy(t, c); got != "primary" {
+		t.Fatalf("served by %s, want primary", got)
+	}
+	checkRequests(t, primary, fallback, 2, 1)
+}
+
+func TestBackendNoFailover(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		sentinel error
+	}{
+		{"rate limited", http.StatusTooManyRequests, jikan.ErrRateLimited},
+		{"not found", http.StatusNotFound, jikan.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary, fallback := newBackends(t)
+			primary.ServerError("", tt.status, 1)
+			c := failoverClient(primary, fallback)
+
+			_, err := c.Anime.ByID(context.Background(), 1)
+			if !errors.Is(err, tt.sentinel) {
+				t.Fatalf("errors.Is(%v, %v) = false", err, tt.sentinel)
+			}
+			var re *jikan.RequestError
+			if !errors.As(err, &re) || re.Backend != primary.URL+"/v4" {
+				t.Errorf("RequestError = %+v, want it from the primary", re)
+			}
+
+			// The primary answered, so it is not cooling down.
+			if got := servedBy(t, c); got != "primary" {
+				t.Fatalf("served by %s, want primary", got)
+			}
+			checkRequests(t, primary, fallback, 2, 0)
+		})
+	}
+}
+
+func TestBaseURL(t *testing.T) {
+	srv := jikantest.NewServer()
+	defer srv.Close()
+	srv.AddAnime(jikan.Anime{MalID: 1})
+
+	for _, base := range []string{srv.URL, srv.URL + "/", srv.URL + "/v4", srv.URL + "/v4/"} {
+		c := jikan.New(jikan.WithBaseURL(base))
+		if _, err := c.Anime.ByID(context.Background(), 1); err != nil {
+			t.Errorf("%s: %v", base, err)
+		}
+	}
+	for i, r := range srv.Requests() {
+		if r != "/v4/anime/1" {
+			t.Errorf("request %d = %s, want /v4/anime/1", i, r)
+		}
+	}
+
+	for _, base := range []string{"ftp://example.com", "example.com/v4", "http://", "http://example.com/v4?x=1", "http://u:p@example.com"} {
+		_, err := jikan.New(jikan.WithBaseURL(base)).Anime.ByID(context.Background(), 1)
+		var ve *jikan.ValidationError
+		if !errors.As(err, &ve) || ve.Field != "base URL" {
+			t.Errorf("%s: got %v, want a base URL validation error", base, err)
+		}
+	}
+}
+
+func TestBackendCacheKey(t *testing.T) {
+	primary, fallback := newBackends(t)
+	cache := jikan.NewMemoryCache()
+	defer cache.Stop()
+	a := jikan.New(jikan.WithBaseURL(primary.URL), jikan.WithCache(cache, time.Minute))
+	b := jikan.New(jikan.WithBaseURL(fallback.URL), jikan.WithCache(cache, time.Minute))
+
+	if got := servedBy(t, a); got != "primary" {
+		t.Fatalf("served by %s, want primary", got)
+	}
+	// Same path and cache, different backend: not a hit.
+	if got := servedBy(t, b); got != "fallback" {
+		t.Fatalf("served by %s, want fallback", got)
+	}
+	if got := servedBy(t, a); got != "primary" {
+		t.Fatalf("served by %s, want primary", got)
+	}
+	checkRequests(t, primary, fallback, 1, 1)
+
+	// A response cached from a fallback is found by a client that fails
+	// over to it.
+	cache2 := jikan.NewMemoryCache()
+	defer cache2.Stop()
+	c := failoverClient(primary, fallback, jikan.WithCache(cache2, time.Minute))
+	primary.ServerError("", http.StatusInternalServerError, 1)
+	if got := servedBy(t, c); got != "fallback" {
+		t.Fatalf("served by %s, want fallback", got)
+	}
+	if got := servedBy(t, c); got != "fallback" {
+		t.Fatalf("served by %s, want the cached fallback response", got)
+	}
+	checkRequests(t, primary, fallback, 2, 2)
+}
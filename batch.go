@@ -0,0 +1,151 @@
+package jikan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"sync"
+)
+
+// BatchOptions controls the ByIDs helpers.
+type BatchOptions struct {
+	// Concurrency is the number of lookups in flight at once. Requests still
+	// wait on the client's rate limiter, so raising it past the limiter
+	// burst only helps when responses come from the cache.
+	Concurrency int
+}
+
+// BatchResult holds the outcome of a ByIDs call. Every requested ID ends
+// up in exactly one of the two maps.
+type BatchResult[T any] struct {
+	Items  map[ID]*T
+	Errors map[ID]error
+}
+
+// Err joins the per-ID errors, or returns nil if every lookup succeeded.
+// A nil result has no errors.
+func (r *BatchResult[T]) Err() error {
+	if r == nil || len(r.Errors) == 0 {
+		return nil
+	}
+	errs := make([]error, 0, len(r.Errors))
+	for _, err := range r.Errors {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// batch runs lookup for every distinct id with bounded concurrency.
+// Failures are recorded per ID instead of stopping the rest. Once ctx is
+// done no further lookups start, and the IDs not yet looked up get the
+// context's error.
+func batch[T any](ctx context.Context, ids []ID, opts BatchOptions, lookup func(context.Context, ID) (*T, error)) *BatchResult[T] {
+	res := &BatchResult[T]{
+		Items:  make(map[ID]*T, len(ids)),
+		Errors: make(map[ID]error),
+	}
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = defaultBurst
+	}
+
+	jobs := make(chan ID)
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	record := func(id ID, v *T, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			res.Errors[id] = err
+		} else {
+			res.Items[id] = v
+		}
+	}
+	for range min(workers, len(ids)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				if err := ctx.Err(); err != nil {
+					record(id, nil, err)
+					continue
+				}
+				v, err := lookup(ctx, id)
+				record(id, v, err)
+			}
+		}()
+	}
+
+	seen := make(map[ID]bool, len(ids))
+	var skipped []ID
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if ctx.Err() != nil {
+			skipped = append(skipped, id)
+			continue
+		}
+		select {
+		case jobs <- id:
+		case <-ctx.Done():
+			skipped = append(skipped, id)
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	for _, id := range skipped {
+		res.Errors[id] = ctx.Err()
+	}
+	return res
+}
+
+// fromList looks ids up in a list endpoint, for entities Jikan has no
+// by-ID route for. The walk stops once every id has been seen, or, for a
+// list in ID order, once it has passed the largest. IDs the list lacks
+// get an ErrNotFound error; if the walk fails the rest get its error.
+func fromList[T any](list iter.Seq2[*T, error], idOf func(*T) ID, ids []ID, sorted bool, kind string) *BatchResult[T] {
+	res := &BatchResult[T]{
+		Items:  make(map[ID]*T, len(ids)),
+		Errors: make(map[ID]error),
+	}
+	want := make(map[ID]bool, len(ids))
+	var top ID
+	for _, id := range ids {
+		if err := checkID(kind+" id", id); err != nil {
+			res.Errors[id] = err
+			continue
+		}
+		want[id] = true
+		top = max(top, id)
+	}
+	var failed error
+	if len(want) > 0 {
+		for v, err := range list {
+			if err != nil {
+				failed = err
+				break
+			}
+			id := idOf(v)
+			if want[id] {
+				res.Items[id] = v
+				delete(want, id)
+			}
+			if len(want) == 0 || sorted && id >= top {
+				break
+			}
+		}
+	}
+	for id := range want {
+		if failed != nil {
+			res.Errors[id] = failed
+		} else {
+			res.Errors[id] = fmt.Errorf("%w: %s %d", ErrNotFound, kind, id)
+		}
+	}
+	return res
+}
@@ -0,0 +1,123 @@
+package jikan_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Sethispr/jikanGo"
+	"github.com/Sethispr/jikanGo/jikantest"
+)
+
+// countTransport counts the requests handed to the network.
+type countTransport struct {
+	n atomic.Int32
+}
+
+func (t *countTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.n.Add(1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestByIDsOrderAndDedup(t *testing.T) {
+	c, srv := jikantest.NewClient(t)
+	srv.AddAnime(jikan.Anime{MalID: 1}, jikan.Anime{MalID: 2}, jikan.Anime{MalID: 3})
+	res := c.Anime.ByIDs(context.Background(), []jikan.ID{3, 1, 3, 2, 1}, jikan.BatchOptions{Concurrency: 1})
+	if err := res.Err(); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"/v4/anime/3", "/v4/anime/1", "/v4/anime/2"}
+	if got := srv.Requests(); !slices.Equal(got, want) {
+		t.Errorf("requested %v, want %v", got, want)
+	}
+	if len(res.Items) != 3 {
+		t.Fatalf("got %d items, want 3", len(res.Items))
+	}
+	for id, a := range res.Items {
+		if a.MalID != id {
+			t.Errorf("item %d holds anime %d", id, a.MalID)
+		}
+	}
+}
+
+func TestByIDsErrors(t *testing.T) {
+	c, srv := jikantest.NewClient(t)
+	srv.AddAnime(jikan.Anime{MalID: 1}, jikan.Anime{MalID: 3})
+	srv.ServerError("/anime/3", http.StatusServiceUnavailable, 0)
+	res := c.Anime.ByIDs(context.Background(), []jikan.ID{1, 2, 3, 0}, jikan.BatchOptions{})
+	if len(res.Items) != 1 || res.Items[1] == nil {
+		t.Fatalf("items are %v, want only 1", res.Items)
+	}
+	for id, target := range map[jikan.ID]error{
+		2: jikan.ErrNotFound,
+		3: jikan.ErrUpstreamUnavailable,
+		0: jikan.ErrInvalidArgument,
+	} {
+		if !errors.Is(res.Errors[id], target) {
+			t.Errorf("error for %d is %v, want %v", id, res.Errors[id], target)
+		}
+		if !errors.Is(res.Err(), target) {
+			t.Errorf("Err() = %v, does not match %v", res.Err(), target)
+		}
+	}
+	if len(res.Errors) != 3 {
+		t.Errorf("got %d errors, want 3", len(res.Errors))
+	}
+}
+
+func TestBatchResultErrNil(t *testing.T) {
+	var res *jikan.BatchResult[jikan.Anime]
+	if err := res.Err(); err != nil {
+		t.Errorf("nil result: Err() = %v", err)
+	}
+	res = &jikan.BatchResult[jikan.Anime]{Items: map[jikan.ID]*jikan.Anime{1: {}}}
+	if err := res.Err(); err != nil {
+		t.Errorf("no errors: Err() = %v", err)
+	}
+}
+
+func TestByIDsCancel(t *testing.T) {
+	ids := make([]jikan.ID, 20)
+	for i := range ids {
+		ids[i] = jikan.ID(i + 1)
+	}
+	check := func(t *testing.T, res *jikan.BatchResult[jikan.Anime]) {
+		t.Helper()
+		if n := len(res.Items) + len(res.Errors); n != len(ids) {
+			t.Errorf("%d IDs have a result, want %d", n, len(ids))
+		}
+		for id, err := range res.Errors {
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("error for %d is %v, want context.Canceled", id, err)
+			}
+		}
+	}
+
+	t.Run("before", func(t *testing.T) {
+		tr := &countTransport{}
+		c, _ := jikantest.NewClient(t, jikan.WithHTTPClient(&http.Client{Transport: tr}))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		res := c.Anime.ByIDs(ctx, ids, jikan.BatchOptions{Concurrency: 2})
+		check(t, res)
+		if n := tr.n.Load(); n != 0 {
+			t.Errorf("made %d requests after cancellation", n)
+		}
+	})
+	t.Run("during", func(t *testing.T) {
+		tr := &countTransport{}
+		c, srv := jikantest.NewClient(t, jikan.WithHTTPClient(&http.Client{Transport: tr}))
+		srv.SetLatency(time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(20*time.Millisecond, cancel)
+		res := c.Anime.ByIDs(ctx, ids, jikan.BatchOptions{Concurrency: 2})
+		check(t, res)
+		if n := tr.n.Load(); n != 2 {
+			t.Errorf("made %d requests, want only the 2 in flight when cancelled", n)
+		}
+	})
+}
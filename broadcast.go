@@ -0,0 +1,146 @@
+package jikan
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultBroadcastZone is used when Jikan leaves the broadcast timezone
+// empty. Nearly every weekly slot on MAL is listed in Japan time.
+const DefaultBroadcastZone = "Asia/Tokyo"
+
+// BroadcastSlot is the weekly air time from Broadcast in parsed form.
+type BroadcastSlot struct {
+	Weekday  time.Weekday
+	Hour     int
+	Minute   int
+	Location *time.Location
+}
+
+// Slot parses the day, time and timezone of a weekly broadcast.
+func (b Broadcast) Slot() (BroadcastSlot, error) {
+	var s BroadcastSlot
+	day, ok := parseWeekday(b.Day)
+	if !ok {
+		return s, fmt.Errorf("unknown broadcast day: %q", b.Day)
+	}
+	clock, err := time.Parse("15:04", b.Time)
+	if err != nil {
+		return s, fmt.Errorf("unknown broadcast time: %q", b.Time)
+	}
+	zone := b.Timezone
+	if zone == "" {
+		zone = DefaultBroadcastZone
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return s, err
+	}
+	s.Weekday = day
+	s.Hour, s.Minute = clock.Hour(), clock.Minute()
+	s.Location = loc
+	return s, nil
+}
+
+// parseWeekday accepts MAL's plural day names ("Saturdays") as well as
+// the singular form.
+func parseWeekday(s string) (time.Weekday, bool) {
+	s = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(s)), "s")
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.ToLower(d.String()) == s {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// Next returns the first slot at or after t, in the slot's own zone.
+func (s BroadcastSlot) Next(t time.Time) time.Time {
+	t = t.In(s.Location)
+	c := time.Date(t.Year(), t.Month(), t.Day(), s.Hour, s.Minute, 0, 0, s.Location)
+	c = c.AddDate(0, 0, (int(s.Weekday)-int(c.Weekday())+7)%7)
+	if c.Before(t) {
+		c = c.AddDate(0, 0, 7)
+	}
+	return c
+}
+
+var durationPart = regexp.MustCompile(`(\d+)\s*(hr|min|sec)`)
+
+// ParseEpisodeDuration reads MAL duration text such as "24 min per ep" or
+// "1 hr 55 min". It reports false when no duration could be found.
+func ParseEpisodeDuration(s string) (time.Duration, bool) {
+	var d time.Duration
+	matches := durationPart.FindAllStringSubmatch(s, -1)
+	for _, m := range matches {
+		n, _ := strconv.Atoi(m[1])
+		switch m[2] {
+		case "hr":
+			d += time.Duration(n) * time.Hour
+		case "min":
+			d += time.Duration(n) * time.Minute
+		case "sec":
+			d += time.Duration(n) * time.Second
+		}
+	}
+	return d, len(matches) > 0
+}
+
+// EpisodeDuration returns the parsed per-episode runtime.
+func (a *Anime) EpisodeDuration() (time.Duration, bool) {
+	return ParseEpisodeDuration(a.Duration)
+}
+
+// periodEnd returns the start of the period after d in loc, so an end
+// date known only to the year or month covers the whole of it.
+func periodEnd(d Date, loc *time.Location) time.Time {
+	switch d.Precision {
+	case PrecisionYear:
+		return time.Date(d.Year()+1, time.January, 1, 0, 0, 0, 0, loc)
+	case PrecisionMonth:
+		return time.Date(d.Year(), d.Month()+1, 1, 0, 0, 0, 0, loc)
+	}
+	return time.Date(d.Year(), d.Month(), d.Day()+1, 0, 0, 0, 0, loc)
+}
+
+// NextAirings returns up to n broadcast times after t, converted to loc
+// (or left in the broadcast zone when loc is nil). The first episode is
+// taken to air in the first slot on or after the Aired start date, and no
+// times are returned past the Aired end date or the final episode.
+func (a *Anime) NextAirings(t time.Time, n int, loc *time.Location) ([]time.Time, error) {
+	slot, err := a.Broadcast.Slot()
+	if err != nil {
+		return nil, err
+	}
+	if loc == nil {
+		loc = slot.Location
+	}
+
+	var first, end time.Time
+	if from := a.Aired.From; from.Valid() {
+		first = slot.Next(time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, slot.Location))
+		if first.After(t) {
+			t = first
+		}
+	}
+	if to := a.Aired.To; to.Valid() {
+		end = periodEnd(to, slot.Location)
+	}
+
+	var out []time.Time
+	for at := slot.Next(t); len(out) < n; at = at.AddDate(0, 0, 7) {
+		if !end.IsZero() && !at.Before(end) {
+			break
+		}
+		if eps, ok := a.Episodes.Get(); ok && !first.IsZero() {
+			if episode := int(at.Sub(first).Round(24*time.Hour) / (7 * 24 * time.Hour)); episode >= eps {
+				break
+			}
+		}
+		out = append(out, at.In(loc))
+	}
+	return out, nil
+}
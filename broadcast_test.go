@@ -0,0 +1,208 @@
+package jikan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Sethispr/jikanGo"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s unavailable: %v", name, err)
+	}
+	return loc
+}
+
+func TestBroadcastSlot(t *testing.T) {
+	tests := []struct {
+		in      jikan.Broadcast
+		weekday time.Weekday
+		hour    int
+		minute  int
+		zone    string
+		wantErr bool
+	}{
+		{jikan.Broadcast{Day: "Saturdays", Time: "01:30", Timezone: "Asia/Tokyo"}, time.Saturday, 1, 30, "Asia/Tokyo", false},
+		{jikan.Broadcast{Day: "sunday", Time: "23:00"}, time.Sunday, 23, 0, "Asia/Tokyo", false},
+		{jikan.Broadcast{Day: "Mondays", Time: "09:15", Timezone: "America/New_York"}, time.Monday, 9, 15, "America/New_York", false},
+		{jikan.Broadcast{Day: "Unknown", Time: "01:30"}, 0, 0, 0, "", true},
+		{jikan.Broadcast{Day: "Fridays", Time: ""}, 0, 0, 0, "", true},
+	}
+	for _, tt := range tests {
+		s, err := tt.in.Slot()
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Slot(%+v) succeeded, want error", tt.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Slot(%+v): %v", tt.in, err)
+			continue
+		}
+		if s.Weekday != tt.weekday || s.Hour != tt.hour || s.Minute != tt.minute || s.Location.String() != tt.zone {
+			t.Errorf("Slot(%+v) = %v %02d:%02d %v", tt.in, s.Weekday, s.Hour, s.Minute, s.Location)
+		}
+	}
+}
+
+func TestBroadcastSlotNext(t *testing.T) {
+	tokyo := mustLoad(t, "Asia/Tokyo")
+	slot := jikan.BroadcastSlot{Weekday: time.Saturday, Hour: 1, Minute: 30, Location: tokyo}
+	tests := []struct {
+		name string
+		at   time.Time
+		want time.Time
+	}{
+		// Friday 16:30 UTC is already Saturday 01:30 in Tokyo.
+		{"exact slot", time.Date(2024, 4, 5, 16, 30, 0, 0, time.UTC), time.Date(2024, 4, 6, 1, 30, 0, 0, tokyo)},
+		{"just after", time.Date(2024, 4, 5, 16, 31, 0, 0, time.UTC), time.Date(2024, 4, 13, 1, 30, 0, 0, tokyo)},
+		{"earlier in week", time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 6, 1, 30, 0, 0, tokyo)},
+		{"across year end", time.Date(2024, 12, 29, 0, 0, 0, 0, tokyo), time.Date(2025, 1, 4, 1, 30, 0, 0, tokyo)},
+	}
+	for _, tt := range tests {
+		if got := slot.Next(tt.at); !got.Equal(tt.want) {
+			t.Errorf("%s: Next(%v) = %v, want %v", tt.name, tt.at, got, tt.want)
+		}
+	}
+}
+
+func TestNextAirings(t *testing.T) {
+	tokyo := mustLoad(t, "Asia/Tokyo")
+	newYork := mustLoad(t, "America/New_York")
+	weekly := func(day, clock, zone string, from, to string, eps int) *jikan.Anime {
+		a := &jikan.Anime{Broadcast: jikan.Broadcast{Day: day, Time: clock, Timezone: zone}}
+		a.Aired.From = jikan.ParseDate(from)
+		a.Aired.To = jikan.ParseDate(to)
+		if eps > 0 {
+			a.Episodes = jikan.Some(eps)
+		}
+		return a
+	}
+	tests := []struct {
+		name  string
+		anime *jikan.Anime
+		at    time.Time
+		n     int
+		loc   *time.Location
+		want  []time.Time
+	}{
+		{
+			// New York moves to daylight time on 2024-03-10, so the Tokyo
+			// slot lands an hour later in local time from then on.
+			name:  "JST to local across DST",
+			anime: weekly("Saturdays", "01:30", "Asia/Tokyo", "2024-01-06", "", 0),
+			at:    time.Date(2024, 3, 1, 0, 0, 0, 0, newYork),
+			n:     3,
+			loc:   newYork,
+			want: []time.Time{
+				time.Date(2024, 3, 1, 11, 30, 0, 0, newYork),
+				time.Date(2024, 3, 8, 11, 30, 0, 0, newYork),
+				time.Date(2024, 3, 15, 12, 30, 0, 0, newYork),
+			},
+		},
+		{
+			name:  "slot zone with DST keeps wall time",
+			anime: weekly("Sundays", "09:00", "America/New_York", "2024-03-03", "", 0),
+			at:    time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			n:     3,
+			loc:   time.UTC,
+			want: []time.Time{
+				time.Date(2024, 3, 3, 14, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 10, 13, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 17, 13, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "stops after final episode across DST",
+			anime: weekly("Sundays", "09:00", "America/New_York", "2024-03-03", "", 2),
+			at:    time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			n:     5,
+			loc:   time.UTC,
+			want: []time.Time{
+				time.Date(2024, 3, 3, 14, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 10, 13, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "starts at the first airing",
+			anime: weekly("Saturdays", "01:30", "Asia/Tokyo", "2024-04-06", "", 0),
+			at:    time.Date(2024, 1, 1, 0, 0, 0, 0, tokyo),
+			n:     1,
+			want:  []time.Time{time.Date(2024, 4, 6, 1, 30, 0, 0, tokyo)},
+		},
+		{
+			name:  "day end date includes that day",
+			anime: weekly("Saturdays", "01:30", "Asia/Tokyo", "2024-04-06", "2024-04-13", 0),
+			at:    time.Date(2024, 4, 1, 0, 0, 0, 0, tokyo),
+			n:     5,
+			want: []time.Time{
+				time.Date(2024, 4, 6, 1, 30, 0, 0, tokyo),
+				time.Date(2024, 4, 13, 1, 30, 0, 0, tokyo),
+			},
+		},
+		{
+			name:  "month end date covers the month",
+			anime: weekly("Saturdays", "01:30", "Asia/Tokyo", "2024-04-06", "2024-04", 0),
+			at:    time.Date(2024, 4, 20, 0, 0, 0, 0, tokyo),
+			n:     5,
+			want: []time.Time{
+				time.Date(2024, 4, 20, 1, 30, 0, 0, tokyo),
+				time.Date(2024, 4, 27, 1, 30, 0, 0, tokyo),
+			},
+		},
+		{
+			name:  "year end date covers the year",
+			anime: weekly("Saturdays", "01:30", "Asia/Tokyo", "2024-01-06", "2024", 0),
+			at:    time.Date(2024, 12, 20, 0, 0, 0, 0, tokyo),
+			n:     5,
+			want: []time.Time{
+				time.Date(2024, 12, 21, 1, 30, 0, 0, tokyo),
+				time.Date(2024, 12, 28, 1, 30, 0, 0, tokyo),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.anime.NextAirings(tt.at, tt.n, tt.loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d airings %v, want %v", len(got), got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("airing %d = %v, want %v", i, got[i], tt.want[i])
+				}
+				if tt.loc != nil && got[i].Location() != tt.loc {
+					t.Errorf("airing %d in %v, want %v", i, got[i].Location(), tt.loc)
+				}
+			}
+		})
+	}
+}
+
+func TestParseEpisodeDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+		ok   bool
+	}{
+		{"24 min per ep", 24 * time.Minute, true},
+		{"1 hr 55 min", time.Hour + 55*time.Minute, true},
+		{"2 hr", 2 * time.Hour, true},
+		{"45 sec per ep", 45 * time.Second, true},
+		{"Unknown", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := jikan.ParseEpisodeDuration(tt.in)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ParseEpisodeDuration(%q) = %v, %v; want %v, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package jikan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type fileItem struct {
+	Data   json.RawMessage `json:"data"`
+	Expiry int64           `json:"expiry"`
+}
+
+// FileCache keeps one JSON file per key in a directory, so cached
+// responses survive restarts and can be shared by processes on one host.
+// Expired entries are removed when they are read.
+type FileCache struct {
+	dir string
+}
+
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileCache{dir: dir}, nil
+}
+
+func (c *FileCache) path(key string) string {
+	return filepath.Join(c.dir, filepath.Base(key)+".json")
+}
+
+func (c *FileCache) Get(ctx context.Context, key string, dst interface{}) error {
+	b, err := os.ReadFile(c.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return CacheMissError{}
+	}
+	if err != nil {
+		return err
+	}
+	var item fileItem
+	if err := json.Unmarshal(b, &item); err != nil || time.Now().UnixNano() > item.Expiry {
+		os.Remove(c.path(key))
+		return CacheMissError{}
+	}
+	return json.Unmarshal(item.Data, dst)
+}
+
+func (c *FileCache) Set(ctx context.Context, key string, val interface{}, ttl time.Duration) error {
+	data, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(fileItem{Data: data, Expiry: time.Now().Add(ttl).UnixNano()})
+	if err != nil {
+		return err
+	}
+	// Write through a temporary file so concurrent readers never see a
+	// partial entry.
+	tmp, err := os.CreateTemp(c.dir, filepath.Base(key)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), c.path(key))
+}
+
+func (c *FileCache) Delete(ctx context.Context, key string) error {
+	err := os.Remove(c.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
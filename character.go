@@ -2,10 +2,11 @@ package jikan
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
-	"strconv"
 )
 
 type CharacterService struct {
@@ -13,14 +14,14 @@ type CharacterService struct {
 }
 
 type Character struct {
-	MalID     ID       `json:"mal_id"`
-	URL       string   `json:"url"`
-	Images    ImageSet `json:"images"`
-	Name      string   `json:"name"`
-	NameKanji string   `json:"name_kanji"`
-	Nicknames []string `json:"nicknames"`
-	About     string   `json:"about"`
-	Favorites int      `json:"favorites"`
+	MalID     ID            `json:"mal_id"`
+	URL       string        `json:"url"`
+	Images    ImageSet      `json:"images"`
+	Name      string        `json:"name"`
+	NameKanji string        `json:"name_kanji"`
+	Nicknames []string      `json:"nicknames"`
+	About     string        `json:"about"`
+	Favorites Optional[int] `json:"favorites"`
 }
 
 type Entry struct {
@@ -40,12 +41,7 @@ type CharacterManga struct {
 	Manga Entry  `json:"manga"`
 }
 
-type VoiceActor struct {
-	MalID  ID       `json:"mal_id"`
-	URL    string   `json:"url"`
-	Images ImageSet `json:"images"`
-	Name   string   `json:"name"`
-}
+type VoiceActor = PersonMeta
 
 type CharacterVoice struct {
 	Language string     `json:"language"`
@@ -64,15 +60,8 @@ type CharacterFull struct {
 	Voices    []CharacterVoice `json:"voices"`
 }
 
-func (s *CharacterService) validateID(id ID) error {
-	if id < 1 {
-		return fmt.Errorf("invalid character id: %d", id)
-	}
-	return nil
-}
-
 func (s *CharacterService) ByID(ctx context.Context, id ID) (*Character, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("character id", id); err != nil {
 		return nil, err
 	}
 	var r struct {
@@ -84,8 +73,24 @@ func (s *CharacterService) ByID(ctx context.Context, id ID) (*Character, error)
 	return &r.Data, nil
 }
 
+// ByIDRaw is ByID plus the character JSON as Jikan sent it.
+func (s *CharacterService) ByIDRaw(ctx context.Context, id ID) (*Character, json.RawMessage, error) {
+	if err := checkID("character id", id); err != nil {
+		return nil, nil, err
+	}
+	r, raw, err := fetchRaw[Character](ctx, s.c, fmt.Sprintf("/characters/%d", id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &r, raw, nil
+}
+
+func (s *CharacterService) ByIDs(ctx context.Context, ids []ID, opts BatchOptions) *BatchResult[Character] {
+	return batch(ctx, ids, opts, s.ByID)
+}
+
 func (s *CharacterService) Full(ctx context.Context, id ID) (*CharacterFull, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("character id", id); err != nil {
 		return nil, err
 	}
 	var r struct {
@@ -98,7 +103,7 @@ func (s *CharacterService) Full(ctx context.Context, id ID) (*CharacterFull, err
 }
 
 func (s *CharacterService) Anime(ctx context.Context, id ID) ([]CharacterAnime, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("character id", id); err != nil {
 		return nil, err
 	}
 	var r struct {
@@ -111,7 +116,7 @@ func (s *CharacterService) Anime(ctx context.Context, id ID) ([]CharacterAnime,
 }
 
 func (s *CharacterService) Manga(ctx context.Context, id ID) ([]CharacterManga, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("character id", id); err != nil {
 		return nil, err
 	}
 	var r struct {
@@ -124,7 +129,7 @@ func (s *CharacterService) Manga(ctx context.Context, id ID) ([]CharacterManga,
 }
 
 func (s *CharacterService) Voices(ctx context.Context, id ID) ([]CharacterVoice, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("character id", id); err != nil {
 		return nil, err
 	}
 	var r struct {
@@ -137,7 +142,7 @@ func (s *CharacterService) Voices(ctx context.Context, id ID) ([]CharacterVoice,
 }
 
 func (s *CharacterService) Pictures(ctx context.Context, id ID) ([]CharacterPicture, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("character id", id); err != nil {
 		return nil, err
 	}
 	var r struct {
@@ -150,13 +155,13 @@ func (s *CharacterService) Pictures(ctx context.Context, id ID) ([]CharacterPict
 }
 
 func (s *CharacterService) Search(ctx context.Context, query string, page int) ([]*Character, *Pagination, error) {
-	q := url.Values{}
+	if err := checkPage(page); err != nil {
+		return nil, nil, err
+	}
+	q := pageQuery(page)
 	if query != "" {
 		q.Set("q", query)
 	}
-	if page > 0 {
-		q.Set("page", strconv.Itoa(page))
-	}
 	var r struct {
 		Data       []*Character `json:"data"`
 		Pagination Pagination   `json:"pagination"`
@@ -166,3 +171,11 @@ func (s *CharacterService) Search(ctx context.Context, query string, page int) (
 	}
 	return r.Data, &r.Pagination, nil
 }
+
+func (s *CharacterService) SearchAll(ctx context.Context, query string, pageOpts ...PageOption) iter.Seq2[*Character, error] {
+	q := url.Values{}
+	if query != "" {
+		q.Set("q", query)
+	}
+	return paginate[*Character](ctx, s.c, "/characters", q, pageOpts)
+}
@@ -0,0 +1,116 @@
+package jikan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Cursor records how far a paginated walk got. It is plain JSON so it can
+// be written anywhere and handed back through WithCursor to pick up on the
+// page after the last completed one. Offset counts the items of that next
+// page already yielded when the walk stopped partway through it; they are
+// skipped on resume.
+type Cursor struct {
+	Endpoint string `json:"endpoint"`
+	Query    string `json:"query"`
+	Page     int    `json:"page"`
+	Offset   int    `json:"offset,omitempty"`
+	Items    int    `json:"items"`
+	Done     bool   `json:"done"`
+}
+
+func (c Cursor) matches(saved *Cursor) error {
+	if saved.Endpoint != c.Endpoint || saved.Query != c.Query {
+		return fmt.Errorf("cursor is for %s?%s, not %s?%s", saved.Endpoint, saved.Query, c.Endpoint, c.Query)
+	}
+	return nil
+}
+
+// CheckpointStore persists cursors between runs. Load returns a nil cursor
+// when nothing has been saved under key.
+type CheckpointStore interface {
+	Load(ctx context.Context, key string) (*Cursor, error)
+	Save(ctx context.Context, key string, cur Cursor) error
+}
+
+// FileCheckpointStore keeps one JSON file per key in a directory. Writes
+// go through a temporary file and a rename so a crash never leaves a
+// half-written checkpoint behind.
+type FileCheckpointStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+func NewFileCheckpointStore(dir string) (*FileCheckpointStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileCheckpointStore{dir: dir}, nil
+}
+
+// name escapes key into a single file name, so keys that differ only in
+// their directory part, such as "anime/x" and "manga/x", stay apart.
+func (s *FileCheckpointStore) name(key string) string {
+	return url.PathEscape(key)
+}
+
+func (s *FileCheckpointStore) path(key string) string {
+	return filepath.Join(s.dir, s.name(key)+".json")
+}
+
+func (s *FileCheckpointStore) Load(ctx context.Context, key string) (*Cursor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := os.ReadFile(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cur Cursor
+	if err := json.Unmarshal(b, &cur); err != nil {
+		return nil, err
+	}
+	return &cur, nil
+}
+
+func (s *FileCheckpointStore) Save(ctx context.Context, key string, cur Cursor) error {
+	b, err := json.Marshal(cur)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tmp, err := os.CreateTemp(s.dir, s.name(key)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(key))
+}
+
+func (s *FileCheckpointStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err := os.Remove(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,112 @@
+package jikan_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/Sethispr/jikanGo"
+)
+
+func TestFileCheckpointStoreKeys(t *testing.T) {
+	ctx := context.Background()
+	store, err := jikan.NewFileCheckpointStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := []string{"anime/x", "manga/x", "x", "../x", "a b?c"}
+	for i, key := range keys {
+		if err := store.Save(ctx, key, jikan.Cursor{Endpoint: key, Page: i + 1}); err != nil {
+			t.Fatalf("Save(%q): %v", key, err)
+		}
+	}
+	for i, key := range keys {
+		cur, err := store.Load(ctx, key)
+		if err != nil {
+			t.Fatalf("Load(%q): %v", key, err)
+		}
+		if cur == nil || cur.Endpoint != key || cur.Page != i+1 {
+			t.Errorf("Load(%q) = %+v, want page %d", key, cur, i+1)
+		}
+	}
+}
+
+func TestCheckpointResume(t *testing.T) {
+	tests := []struct {
+		name  string
+		first []jikan.PageOption
+		n     int
+		saved jikan.Cursor
+	}{
+		{"completed page", []jikan.PageOption{jikan.MaxPages(1)}, 25, jikan.Cursor{Page: 1, Items: 25}},
+		{"MaxItems mid-page", []jikan.PageOption{jikan.MaxItems(30)}, 30, jikan.Cursor{Page: 1, Offset: 5, Items: 30}},
+		{"MaxItems in the first page", []jikan.PageOption{jikan.MaxItems(10)}, 10, jikan.Cursor{Offset: 10, Items: 10}},
+		{"MaxItems on a page boundary", []jikan.PageOption{jikan.MaxItems(50)}, 50, jikan.Cursor{Page: 2, Items: 50}},
+	}
+	for _, tt := range tests {
+		for _, workers := range []int{1, 3} {
+			t.Run(tt.name+"/workers="+strconv.Itoa(workers), func(t *testing.T) {
+				ctx := context.Background()
+				store, err := jikan.NewFileCheckpointStore(t.TempDir())
+				if err != nil {
+					t.Fatal(err)
+				}
+				c, _ := newPagedClient(t, 60, 0)
+				opts := []jikan.PageOption{jikan.Checkpoint(store, "now"), jikan.Concurrency(workers)}
+
+				ids, err := collect(t, c, append(opts, tt.first...)...)
+				if err != nil {
+					t.Fatal(err)
+				}
+				checkSequence(t, ids, tt.n)
+				cur, err := store.Load(ctx, "now")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if cur == nil || cur.Page != tt.saved.Page || cur.Offset != tt.saved.Offset || cur.Items != tt.saved.Items || cur.Done {
+					t.Fatalf("saved %+v, want %+v", cur, tt.saved)
+				}
+
+				ids, err = collect(t, c, opts...)
+				if err != nil {
+					t.Fatal(err)
+				}
+				checkRange(t, ids, tt.n+1, 60-tt.n)
+				cur, err = store.Load(ctx, "now")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cur.Done || cur.Items != 60 || cur.Offset != 0 {
+					t.Fatalf("saved %+v after resuming, want done with 60 items", cur)
+				}
+
+				ids, err = collect(t, c, opts...)
+				if err != nil || len(ids) != 0 {
+					t.Fatalf("walk after done yielded %d items, %v", len(ids), err)
+				}
+			})
+		}
+	}
+}
+
+func TestCursorResumeAfterBreak(t *testing.T) {
+	c, _ := newPagedClient(t, 60, 0)
+	var cur jikan.Cursor
+	var ids []jikan.ID
+	for a, err := range c.Season.NowAll(context.Background(), jikan.SeasonOptions{}, jikan.WithCursor(&cur)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ids = append(ids, a.MalID); len(ids) == 40 {
+			break
+		}
+	}
+	if cur.Page != 1 || cur.Offset != 15 || cur.Items != 40 {
+		t.Fatalf("cursor is %+v after breaking at item 40", cur)
+	}
+	rest, err := collect(t, c, jikan.WithCursor(&cur))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSequence(t, append(ids, rest...), 60)
+}
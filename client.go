@@ -3,20 +3,27 @@ package jikan
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"hash/fnv"
+	"io"
 	"net/http"
 	"net/url"
+	"reflect"
 	"time"
 
 	"golang.org/x/time/rate"
 )
 
+// DefaultBaseURL is the public Jikan API.
+const DefaultBaseURL = "https://api.jikan.moe/v4"
+
 const (
-	_baseURL     = "https://api.jikan.moe/v4"
 	_version     = "0.1.0"
 	defaultRPS   = 3
 	defaultBurst = 3
+
+	defaultCacheTTL = 5 * time.Minute
 )
 
 type ctxKey int
@@ -29,13 +36,17 @@ func NoCache(ctx context.Context) context.Context {
 
 type Client struct {
 	client     *http.Client
-	baseURL    *url.URL
+	backends   []*backend
+	err        error
 	agent      string
 	maxRetries int
 	cache      Cache
 	cacheTTL   time.Duration
 	limiter    *rate.Limiter
 
+	drift       func(DriftReport)
+	driftErrors bool
+
 	Anime          *AnimeService
 	Manga          *MangaService
 	Character      *CharacterService
@@ -54,16 +65,14 @@ type Client struct {
 	Random         *RandomService
 }
 
-type Option func(*Client)
-
 func New(opts ...Option) *Client {
-	u, _ := url.Parse(_baseURL)
+	u, _ := url.Parse(DefaultBaseURL)
 	c := &Client{
 		client:     &http.Client{Timeout: 30 * time.Second},
-		baseURL:    u,
+		backends:   []*backend{{base: u}},
 		agent:      "jikan-go/" + _version,
 		maxRetries: 0,
-		cacheTTL:   5 * time.Minute,
+		cacheTTL:   defaultCacheTTL,
 	}
 	for _, o := range opts {
 		o(c)
@@ -72,49 +81,6 @@ func New(opts ...Option) *Client {
 	return c
 }
 
-func WithHTTPClient(hc *http.Client) Option {
-	return func(c *Client) {
-		c.client = hc
-	}
-}
-
-func WithTimeout(d time.Duration) Option {
-	return func(c *Client) {
-		c.client.Timeout = d
-	}
-}
-
-func WithRetries(n int) Option {
-	return func(c *Client) {
-		c.maxRetries = n
-	}
-}
-
-func WithCache(cache Cache, ttl time.Duration) Option {
-	return func(c *Client) {
-		c.cache = cache
-		c.cacheTTL = ttl
-	}
-}
-
-// WithRateLimit enables request rate limiting at rps requests per second.
-// Pass 0 or negative to use default (3 rps).
-func WithRateLimit(rps int) Option {
-	return func(c *Client) {
-		if rps <= 0 {
-			rps = defaultRPS
-		}
-		c.limiter = rate.NewLimiter(rate.Every(time.Second/time.Duration(rps)), defaultBurst)
-	}
-}
-
-// WithRateLimiter accepts a pre configured rate limiter for more customizable control.
-func WithRateLimiter(l *rate.Limiter) Option {
-	return func(c *Client) {
-		c.limiter = l
-	}
-}
-
 func (c *Client) initServices() {
 	c.Anime = &AnimeService{c}
 	c.Manga = &MangaService{c}
@@ -135,17 +101,36 @@ func (c *Client) initServices() {
 }
 
 func (c *Client) Do(ctx context.Context, method, path string, q url.Values, v interface{}) error {
-	// Apply rate limiting if you configured it
-	if c.limiter != nil {
-		if err := c.limiter.Wait(ctx); err != nil {
-			return err
+	var (
+		attempts []Attempt
+		served   *backend
+	)
+	fail := func(err error, body []byte) error {
+		e := &RequestError{
+			Method:   method,
+			Endpoint: EndpointTemplate(path),
+			URL:      path,
+			Attempts: attempts,
+			Body:     snippet(body),
+			Err:      err,
+		}
+		if served != nil {
+			e.Backend = served.String()
+			e.URL = served.url(path, q)
 		}
+		return e
+	}
+	if c.err != nil {
+		return fail(c.err, nil)
 	}
 
-	if c.cache != nil && method == http.MethodGet && v != nil {
-		if skip, _ := ctx.Value(ctxNoCache).(bool); !skip {
-			key := c.cacheKey(method, path, q)
-			if err := c.cache.Get(ctx, key, v); err == nil {
+	useCache := c.cache != nil && method == http.MethodGet && v != nil
+	if skip, _ := ctx.Value(ctxNoCache).(bool); skip {
+		useCache = false
+	}
+	if useCache {
+		for _, b := range c.backends {
+			if err := c.cache.Get(ctx, c.cacheKey(b, method, path, q, v), v); err == nil {
 				return nil
 			}
 		}
@@ -160,61 +145,122 @@ func (c *Client) Do(ctx context.Context, method, path string, q url.Values, v in
 			case <-time.After(backoff):
 				backoff *= 2
 			case <-ctx.Done():
-				return ctx.Err()
+				return fail(ctx.Err(), nil)
 			}
 		}
 
-		req, err := http.NewRequestWithContext(ctx, method, c.url(path, q), nil)
-		if err != nil {
-			return err
-		}
-		req.Header.Set("User-Agent", c.agent)
-
-		resp, err := c.client.Do(req)
-		if err != nil {
-			lastErr = err
-			continue
-		}
-
-		if resp.StatusCode == 429 || (resp.StatusCode >= 500 && resp.StatusCode < 600) {
-			resp.Body.Close()
-			lastErr = &Error{Status: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
-			continue
-		}
+		// Network errors and 5xx responses move on to the next backend
+		// within the same attempt; anything else is the backend's answer.
+		for _, b := range c.candidates() {
+			// Apply rate limiting if you configured it. Every request
+			// waits, so retries and failovers are limited too, while
+			// cache hits are not.
+			if c.limiter != nil {
+				if err := c.limiter.Wait(ctx); err != nil {
+					return fail(err, nil)
+				}
+			}
+			served = b
+			req, err := http.NewRequestWithContext(ctx, method, b.url(path, q), nil)
+			if err != nil {
+				return fail(err, nil)
+			}
+			req.Header.Set("User-Agent", c.agent)
 
-		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-			defer resp.Body.Close()
-			return parseError(resp)
-		}
+			start := time.Now()
+			resp, err := c.client.Do(req)
+			if err != nil {
+				attempts = append(attempts, Attempt{Backend: b.String(), Err: err, Duration: time.Since(start)})
+				if ctx.Err() != nil {
+					return fail(err, nil)
+				}
+				b.markDown(now())
+				lastErr = err
+				continue
+			}
 
-		if v != nil {
-			if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+				apiErr := parseError(resp)
 				resp.Body.Close()
-				return err
+				attempts = append(attempts, Attempt{Backend: b.String(), Status: resp.StatusCode, Err: apiErr, Duration: time.Since(start)})
+				if apiErr.IsServerError() {
+					b.markDown(now())
+					lastErr = apiErr
+					continue
+				}
+				b.markUp()
+				if apiErr.IsRateLimit() {
+					lastErr = apiErr
+					break
+				}
+				return fail(apiErr, nil)
 			}
-		}
-		resp.Body.Close()
+			b.markUp()
 
-		if c.cache != nil && method == http.MethodGet && v != nil {
-			if skip, _ := ctx.Value(ctxNoCache).(bool); !skip {
-				key := c.cacheKey(method, path, q)
-				_ = c.cache.Set(ctx, key, v, c.cacheTTL)
+			var body []byte
+			if v != nil {
+				body, err = io.ReadAll(resp.Body)
 			}
+			resp.Body.Close()
+			attempts = append(attempts, Attempt{Backend: b.String(), Status: resp.StatusCode, Err: err, Duration: time.Since(start)})
+			if err != nil {
+				return fail(err, nil)
+			}
+			if v != nil {
+				if err := c.decode(body, path, v); err != nil {
+					var de *DriftError
+					if errors.As(err, &de) {
+						return fail(err, nil)
+					}
+					return fail(err, body)
+				}
+			}
+
+			if useCache {
+				_ = c.cache.Set(ctx, c.cacheKey(b, method, path, q, v), v, c.cacheTTL)
+			}
+			return nil
 		}
-		return nil
 	}
-	return lastErr
+	return fail(lastErr, nil)
 }
 
-func (c *Client) url(path string, q url.Values) string {
-	if len(q) == 0 {
-		return c.baseURL.String() + path
+// decode unmarshals a response body into v, checking it for schema drift
+// when strict decoding is on.
+func (c *Client) decode(body []byte, path string, v interface{}) error {
+	if err := json.Unmarshal(body, v); err != nil {
+		return err
+	}
+	if c.drift == nil && !c.driftErrors {
+		return nil
+	}
+	target := v
+	if raw, ok := v.(*rawResponse); ok {
+		target = raw.v
+	}
+	r, err := driftOf(path, body, reflect.TypeOf(target))
+	if err != nil || r.Empty() {
+		return err
 	}
-	return c.baseURL.String() + path + "?" + q.Encode()
+	if c.drift != nil {
+		c.drift(r)
+	}
+	if c.driftErrors {
+		return &DriftError{Report: r}
+	}
+	return nil
 }
 
-func (c *Client) cacheKey(method, path string, q url.Values) string {
+// cacheKey includes the backend, so responses from a self-hosted
+// instance and the public API are cached separately. Raw requests get
+// their own keys: a typed entry is re-encoded from the model and has lost
+// the fields the model does not declare.
+func (c *Client) cacheKey(b *backend, method, path string, q url.Values, v any) string {
 	h := fnv.New64a()
+	if _, ok := v.(*rawResponse); ok {
+		h.Write([]byte("raw\x00"))
+	}
+	h.Write([]byte(b.String()))
 	h.Write([]byte(method))
 	h.Write([]byte(path))
 	if q != nil {
@@ -2,21 +2,18 @@ package jikan
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
-	"strconv"
 )
 
 type ClubMember struct {
-	Username string `json:"username"`
-	URL      string `json:"url"`
-	Images   struct {
-		JPG struct {
-			ImageURL string `json:"image_url"`
-		} `json:"jpg"`
-	} `json:"images"`
-	LastOnline string `json:"last_online"`
+	Username   string        `json:"username"`
+	URL        string        `json:"url"`
+	Images     BasicImageSet `json:"images"`
+	LastOnline Date          `json:"last_online,omitempty"`
 }
 
 type ClubStaff struct {
@@ -34,26 +31,11 @@ type ClubService struct {
 	c *Client
 }
 
-type Club struct {
-	MalID  int    `json:"mal_id"`
-	Name   string `json:"name"`
-	URL    string `json:"url"`
-	Images struct {
-		JPG struct {
-			ImageURL string `json:"image_url"`
-		} `json:"jpg"`
-		WebP struct {
-			ImageURL string `json:"image_url"`
-		} `json:"webp"`
-	} `json:"images"`
-	Members  int    `json:"members"`
-	Category string `json:"category"`
-	Access   string `json:"access"`
-	Created  string `json:"created"`
-}
-
 // ByID gets a club by their MAL ID
 func (s *ClubService) ByID(ctx context.Context, id ID) (*Club, error) {
+	if err := checkID("club id", id); err != nil {
+		return nil, err
+	}
 	var r struct {
 		Data Club `json:"data"`
 	}
@@ -63,11 +45,28 @@ func (s *ClubService) ByID(ctx context.Context, id ID) (*Club, error) {
 	return &r.Data, nil
 }
 
+// ByIDRaw is ByID plus the raw club JSON.
+func (s *ClubService) ByIDRaw(ctx context.Context, id ID) (*Club, json.RawMessage, error) {
+	if err := checkID("club id", id); err != nil {
+		return nil, nil, err
+	}
+	r, raw, err := fetchRaw[Club](ctx, s.c, fmt.Sprintf("/clubs/%d", id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &r, raw, nil
+}
+
+func (s *ClubService) ByIDs(ctx context.Context, ids []ID, opts BatchOptions) *BatchResult[Club] {
+	return batch(ctx, ids, opts, s.ByID)
+}
+
 func (s *ClubService) Search(ctx context.Context, query string, page int) ([]Club, *Pagination, error) {
-	q := url.Values{
-		"q":    {query},
-		"page": {strconv.Itoa(page)},
+	if err := checkPage(page); err != nil {
+		return nil, nil, err
 	}
+	q := pageQuery(page)
+	q.Set("q", query)
 	var r struct {
 		Data       []Club     `json:"data"`
 		Pagination Pagination `json:"pagination"`
@@ -78,8 +77,15 @@ func (s *ClubService) Search(ctx context.Context, query string, page int) ([]Clu
 	return r.Data, &r.Pagination, nil
 }
 
+func (s *ClubService) SearchAll(ctx context.Context, query string, pageOpts ...PageOption) iter.Seq2[Club, error] {
+	return paginate[Club](ctx, s.c, "/clubs", url.Values{"q": {query}}, pageOpts)
+}
+
 func (s *ClubService) Members(ctx context.Context, id ID, page int) ([]ClubMember, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
+	if err := validate(checkID("club id", id), checkPage(page)); err != nil {
+		return nil, nil, err
+	}
+	q := pageQuery(page)
 	var r struct {
 		Data       []ClubMember `json:"data"`
 		Pagination Pagination   `json:"pagination"`
@@ -90,7 +96,17 @@ func (s *ClubService) Members(ctx context.Context, id ID, page int) ([]ClubMembe
 	return r.Data, &r.Pagination, nil
 }
 
+func (s *ClubService) MembersAll(ctx context.Context, id ID, pageOpts ...PageOption) iter.Seq2[ClubMember, error] {
+	if err := checkID("club id", id); err != nil {
+		return failed[ClubMember](err)
+	}
+	return paginate[ClubMember](ctx, s.c, fmt.Sprintf("/clubs/%d/members", id), nil, pageOpts)
+}
+
 func (s *ClubService) Staff(ctx context.Context, id ID) ([]ClubStaff, error) {
+	if err := checkID("club id", id); err != nil {
+		return nil, err
+	}
 	var r struct {
 		Data []ClubStaff `json:"data"`
 	}
@@ -101,6 +117,9 @@ func (s *ClubService) Staff(ctx context.Context, id ID) ([]ClubStaff, error) {
 }
 
 func (s *ClubService) Relations(ctx context.Context, id ID) (*ClubRelations, error) {
+	if err := checkID("club id", id); err != nil {
+		return nil, err
+	}
 	var r struct {
 		Data ClubRelations `json:"data"`
 	}
@@ -0,0 +1,224 @@
+package jikan
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config holds the client settings services usually take from flags or
+// the environment. The zero value is the same client New returns.
+type Config struct {
+	// BaseURL is the Jikan instance to use; empty means DefaultBaseURL.
+	BaseURL string
+	// FallbackURLs are tried in order when BaseURL fails.
+	FallbackURLs []string
+	// Timeout is the per-request timeout; zero keeps the default.
+	Timeout time.Duration
+	Retries int
+	// RPS turns on rate limiting at this many requests per second.
+	RPS int
+	// CacheDir caches responses on disk in this directory. Without it a
+	// positive CacheTTL caches in memory.
+	CacheDir string
+	CacheTTL time.Duration
+}
+
+// configFile is the JSON form of Config, with durations written as
+// strings such as "10s".
+type configFile struct {
+	BaseURL      string   `json:"base_url"`
+	FallbackURLs []string `json:"fallback_urls"`
+	Timeout      string   `json:"timeout"`
+	Retries      int      `json:"retries"`
+	RPS          int      `json:"rps"`
+	CacheDir     string   `json:"cache_dir"`
+	CacheTTL     string   `json:"cache_ttl"`
+}
+
+// ConfigFromFile reads a JSON config file:
+//
+//	{
+//		"base_url": "http://jikan.internal:8080",
+//		"fallback_urls": ["https://api.jikan.moe/v4"],
+//		"timeout": "10s",
+//		"retries": 3,
+//		"rps": 3,
+//		"cache_dir": "/var/cache/jikan",
+//		"cache_ttl": "1h"
+//	}
+//
+// Unknown keys are an error, as are values LoadEnv would reject.
+func ConfigFromFile(path string) (Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	var f configFile
+	if err := dec.Decode(&f); err != nil {
+		return Config{}, fmt.Errorf("jikan: config %s: %w", path, err)
+	}
+	if err := validate(checkCount("retries", f.Retries), checkCount("rps", f.RPS)); err != nil {
+		return Config{}, err
+	}
+	cfg := Config{
+		BaseURL:      f.BaseURL,
+		FallbackURLs: f.FallbackURLs,
+		Retries:      f.Retries,
+		RPS:          f.RPS,
+		CacheDir:     f.CacheDir,
+	}
+	if cfg.Timeout, err = parseDuration("timeout", f.Timeout); err != nil {
+		return Config{}, err
+	}
+	if cfg.CacheTTL, err = parseDuration("cache_ttl", f.CacheTTL); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// ConfigFromEnv reads a Config from the environment; see LoadEnv.
+func ConfigFromEnv() (Config, error) {
+	var cfg Config
+	err := cfg.LoadEnv()
+	return cfg, err
+}
+
+// LoadEnv overrides cfg with the JIKAN_* variables that are set, so a
+// file can hold the defaults and the environment the per-deployment
+// changes:
+//
+//	JIKAN_BASE_URL       base URL
+//	JIKAN_FALLBACK_URLS  comma-separated fallback URLs
+//	JIKAN_TIMEOUT        request timeout, e.g. 10s
+//	JIKAN_RETRIES        retries on 429 and 5xx
+//	JIKAN_RPS            requests per second
+//	JIKAN_CACHE_DIR      on-disk cache directory
+//	JIKAN_CACHE_TTL      cache TTL, e.g. 1h
+//
+// If a variable is invalid, cfg is left unchanged.
+func (cfg *Config) LoadEnv() error {
+	c := *cfg
+	var err error
+	if v, ok := os.LookupEnv("JIKAN_BASE_URL"); ok {
+		c.BaseURL = v
+	}
+	if v, ok := os.LookupEnv("JIKAN_FALLBACK_URLS"); ok {
+		c.FallbackURLs = nil
+		for _, u := range strings.Split(v, ",") {
+			if u = strings.TrimSpace(u); u != "" {
+				c.FallbackURLs = append(c.FallbackURLs, u)
+			}
+		}
+	}
+	if v, ok := os.LookupEnv("JIKAN_TIMEOUT"); ok {
+		if c.Timeout, err = parseDuration("JIKAN_TIMEOUT", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := os.LookupEnv("JIKAN_RETRIES"); ok {
+		if c.Retries, err = parseCount("JIKAN_RETRIES", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := os.LookupEnv("JIKAN_RPS"); ok {
+		if c.RPS, err = parseCount("JIKAN_RPS", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := os.LookupEnv("JIKAN_CACHE_DIR"); ok {
+		c.CacheDir = v
+	}
+	if v, ok := os.LookupEnv("JIKAN_CACHE_TTL"); ok {
+		if c.CacheTTL, err = parseDuration("JIKAN_CACHE_TTL", v); err != nil {
+			return err
+		}
+	}
+	*cfg = c
+	return nil
+}
+
+func parseDuration(field, s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, invalid(field, strconv.Quote(s), "must be a duration such as 10s")
+	}
+	return d, nil
+}
+
+func parseCount(field, s string) (int, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return 0, invalid(field, strconv.Quote(s), "must be a non-negative integer")
+	}
+	if err := checkCount(field, n); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func checkCount(field string, n int) error {
+	if n < 0 {
+		return invalid(field, n, "must be a non-negative integer")
+	}
+	return nil
+}
+
+// Options returns the options cfg stands for. It fails only when
+// CacheDir cannot be created.
+func (cfg Config) Options() ([]Option, error) {
+	var opts []Option
+	if cfg.BaseURL != "" {
+		opts = append(opts, WithBaseURL(cfg.BaseURL))
+	}
+	for _, u := range cfg.FallbackURLs {
+		opts = append(opts, WithFallbackURL(u))
+	}
+	if cfg.Timeout > 0 {
+		opts = append(opts, WithTimeout(cfg.Timeout))
+	}
+	if cfg.Retries > 0 {
+		opts = append(opts, WithRetries(cfg.Retries))
+	}
+	if cfg.RPS > 0 {
+		opts = append(opts, WithRateLimit(cfg.RPS))
+	}
+	switch {
+	case cfg.CacheDir != "":
+		cache, err := NewFileCache(cfg.CacheDir)
+		if err != nil {
+			return nil, err
+		}
+		ttl := cfg.CacheTTL
+		if ttl <= 0 {
+			ttl = defaultCacheTTL
+		}
+		opts = append(opts, WithCache(cache, ttl))
+	case cfg.CacheTTL > 0:
+		opts = append(opts, WithCache(NewMemoryCache(), cfg.CacheTTL))
+	}
+	return opts, nil
+}
+
+// NewFromConfig returns a client configured by cfg, followed by opts. It
+// reports invalid URLs up front instead of on the first request.
+func NewFromConfig(cfg Config, opts ...Option) (*Client, error) {
+	base, err := cfg.Options()
+	if err != nil {
+		return nil, err
+	}
+	c := New(append(base, opts...)...)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c, nil
+}
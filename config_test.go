@@ -0,0 +1,221 @@
+package jikan_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Sethispr/jikanGo"
+	"github.com/Sethispr/jikanGo/jikantest"
+)
+
+func TestConfigFromFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		invalid bool // wants ErrInvalidArgument rather than any error
+		wantErr bool
+	}{
+		{"valid", `{"base_url": "http://localhost:8080", "timeout": "10s", "retries": 3, "rps": 2, "cache_ttl": "1h"}`, false, false},
+		{"empty", `{}`, false, false},
+		{"unknown key", `{"retry": 3}`, false, true},
+		{"negative retries", `{"retries": -1}`, true, true},
+		{"negative rps", `{"rps": -2}`, true, true},
+		{"negative timeout", `{"timeout": "-1s"}`, true, true},
+		{"bad cache ttl", `{"cache_ttl": "soon"}`, true, true},
+		{"wrong type", `{"retries": "3"}`, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "jikan.json")
+			if err := os.WriteFile(path, []byte(tt.file), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := jikan.ConfigFromFile(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.invalid && !errors.Is(err, jikan.ErrInvalidArgument) {
+				t.Errorf("err = %v, want ErrInvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestConfigFromFileValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jikan.json")
+	file := `{"base_url": "http://localhost:8080", "fallback_urls": ["https://api.jikan.moe/v4"], "timeout": "10s", "retries": 3, "rps": 2, "cache_ttl": "1h"}`
+	if err := os.WriteFile(path, []byte(file), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := jikan.ConfigFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := jikan.Config{
+		BaseURL:      "http://localhost:8080",
+		FallbackURLs: []string{"https://api.jikan.moe/v4"},
+		Timeout:      10 * time.Second,
+		Retries:      3,
+		RPS:          2,
+		CacheTTL:     time.Hour,
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("cfg = %+v, want %+v", cfg, want)
+	}
+}
+
+var envVars = []string{
+	"JIKAN_BASE_URL", "JIKAN_FALLBACK_URLS", "JIKAN_TIMEOUT", "JIKAN_RETRIES",
+	"JIKAN_RPS", "JIKAN_CACHE_DIR", "JIKAN_CACHE_TTL",
+}
+
+// setEnv unsets every JIKAN_* variable for the test, so the host's
+// environment cannot leak in, then sets the ones in env.
+func setEnv(t *testing.T, env map[string]string) {
+	t.Helper()
+	for _, k := range envVars {
+		t.Setenv(k, "")
+		os.Unsetenv(k)
+	}
+	for k, v := range env {
+		t.Setenv(k, v)
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	setEnv(t, map[string]string{
+		"JIKAN_BASE_URL":      "http://localhost:8080",
+		"JIKAN_FALLBACK_URLS": " https://api.jikan.moe/v4, ,http://backup:8080 ",
+		"JIKAN_TIMEOUT":       "10s",
+		"JIKAN_RETRIES":       " 3",
+		"JIKAN_RPS":           "2",
+		"JIKAN_CACHE_DIR":     "/var/cache/jikan",
+		"JIKAN_CACHE_TTL":     "1h",
+	})
+	cfg, err := jikan.ConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := jikan.Config{
+		BaseURL:      "http://localhost:8080",
+		FallbackURLs: []string{"https://api.jikan.moe/v4", "http://backup:8080"},
+		Timeout:      10 * time.Second,
+		Retries:      3,
+		RPS:          2,
+		CacheDir:     "/var/cache/jikan",
+		CacheTTL:     time.Hour,
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("cfg = %+v, want %+v", cfg, want)
+	}
+
+	setEnv(t, nil)
+	if cfg, err := jikan.ConfigFromEnv(); err != nil || !reflect.DeepEqual(cfg, jikan.Config{}) {
+		t.Errorf("empty environment: cfg = %+v, err = %v", cfg, err)
+	}
+}
+
+func TestConfigFromEnvInvalid(t *testing.T) {
+	tests := []struct {
+		key, value string
+	}{
+		{"JIKAN_TIMEOUT", "soon"},
+		{"JIKAN_TIMEOUT", "-1s"},
+		{"JIKAN_RETRIES", "three"},
+		{"JIKAN_RETRIES", "-1"},
+		{"JIKAN_RPS", "1.5"},
+		{"JIKAN_CACHE_TTL", "1 hour"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			setEnv(t, map[string]string{tt.key: tt.value})
+			_, err := jikan.ConfigFromEnv()
+			var ve *jikan.ValidationError
+			if !errors.As(err, &ve) || ve.Field != tt.key {
+				t.Fatalf("err = %v, want a validation error for %s", err, tt.key)
+			}
+			if !errors.Is(err, jikan.ErrInvalidArgument) {
+				t.Errorf("errors.Is(%v, ErrInvalidArgument) = false", err)
+			}
+		})
+	}
+}
+
+func TestConfigEnvOverridesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jikan.json")
+	file := `{"base_url": "http://localhost:8080", "fallback_urls": ["https://api.jikan.moe/v4"], "timeout": "10s", "retries": 3, "rps": 2, "cache_ttl": "1h"}`
+	if err := os.WriteFile(path, []byte(file), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	setEnv(t, map[string]string{
+		"JIKAN_BASE_URL":      "http://jikan.internal:9000",
+		"JIKAN_RETRIES":       "0",
+		"JIKAN_FALLBACK_URLS": "",
+		"JIKAN_CACHE_DIR":     "/tmp/jikan",
+	})
+	cfg, err := jikan.ConfigFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.LoadEnv(); err != nil {
+		t.Fatal(err)
+	}
+	// Variables that are set win, even when empty or zero; the rest keep
+	// the file's values.
+	want := jikan.Config{
+		BaseURL:  "http://jikan.internal:9000",
+		Timeout:  10 * time.Second,
+		Retries:  0,
+		RPS:      2,
+		CacheDir: "/tmp/jikan",
+		CacheTTL: time.Hour,
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("cfg = %+v, want %+v", cfg, want)
+	}
+
+	// A bad variable is reported and leaves cfg as it was.
+	before := cfg
+	setEnv(t, map[string]string{"JIKAN_BASE_URL": "http://other", "JIKAN_RPS": "fast"})
+	if err := cfg.LoadEnv(); !errors.Is(err, jikan.ErrInvalidArgument) {
+		t.Fatalf("err = %v, want ErrInvalidArgument", err)
+	}
+	if !reflect.DeepEqual(cfg, before) {
+		t.Errorf("cfg = %+v after a failed LoadEnv, want %+v", cfg, before)
+	}
+}
+
+func TestNewFromEnv(t *testing.T) {
+	srv := jikantest.NewServer()
+	defer srv.Close()
+	srv.AddAnime(jikan.Anime{MalID: 1, Title: "Cowboy Bebop"})
+
+	setEnv(t, map[string]string{"JIKAN_BASE_URL": srv.URL, "JIKAN_RETRIES": "1"})
+	cfg, err := jikan.ConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := jikan.NewFromConfig(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, err := c.Anime.ByID(context.Background(), 1); err != nil || a.Title != "Cowboy Bebop" {
+		t.Fatalf("ByID = %+v, %v", a, err)
+	}
+	if reqs := srv.Requests(); len(reqs) != 1 || reqs[0] != "/v4/anime/1" {
+		t.Errorf("requests = %v", reqs)
+	}
+
+	setEnv(t, map[string]string{"JIKAN_BASE_URL": "ftp://jikan.internal"})
+	if cfg, err = jikan.ConfigFromEnv(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := jikan.NewFromConfig(cfg); !errors.Is(err, jikan.ErrInvalidArgument) {
+		t.Errorf("NewFromConfig with %s: err = %v, want ErrInvalidArgument", cfg.BaseURL, err)
+	}
+}
@@ -0,0 +1,181 @@
+package jikan
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// DatePrecision says how much of a Date Jikan actually knows.
+type DatePrecision int
+
+const (
+	PrecisionNone DatePrecision = iota
+	PrecisionYear
+	PrecisionMonth
+	PrecisionDay
+	PrecisionTime
+)
+
+// Date is a timestamp from Jikan. It decodes full ISO-8601 timestamps as
+// well as the partial forms MAL uses for things like birthdays, and null.
+// The original text is kept in Raw and is what gets encoded again, so a
+// Date survives a cache round trip unchanged. A Date built in code with
+// an empty Raw is encoded from Time at its Precision.
+type Date struct {
+	time.Time
+	Precision DatePrecision
+	Raw       string
+}
+
+var dateLayouts = []struct {
+	layout    string
+	precision DatePrecision
+}{
+	{time.RFC3339Nano, PrecisionTime},
+	{"2006-01-02T15:04:05", PrecisionTime},
+	{time.DateOnly, PrecisionDay},
+	{"2006-01", PrecisionMonth},
+	{"2006", PrecisionYear},
+}
+
+// ParseDate parses s the same way Date's JSON decoding does. Text that
+// matches none of the known layouts is kept in Raw with PrecisionNone.
+func ParseDate(s string) Date {
+	d := Date{Raw: s}
+	for _, l := range dateLayouts {
+		if t, err := time.Parse(l.layout, s); err == nil {
+			d.Time = t
+			d.Precision = l.precision
+			break
+		}
+	}
+	return d
+}
+
+// Valid reports whether the date was present and parsed.
+func (d Date) Valid() bool { return d.Precision != PrecisionNone }
+
+func (d Date) String() string {
+	if d.Raw == "" {
+		return d.format()
+	}
+	return d.Raw
+}
+
+// format writes Time in the layout ParseDate reads back at d's precision.
+func (d Date) format() string {
+	switch d.Precision {
+	case PrecisionYear:
+		return d.Time.Format("2006")
+	case PrecisionMonth:
+		return d.Time.Format("2006-01")
+	case PrecisionDay:
+		return d.Time.Format(time.DateOnly)
+	case PrecisionTime:
+		return d.Time.Format(time.RFC3339Nano)
+	}
+	return ""
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	s := d.String()
+	if s == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(s)
+}
+
+func (d *Date) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*d = Date{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*d = ParseDate(s)
+	return nil
+}
+
+// trim lowers the precision of d to match the parts Jikan reports as
+// known. MAL fills unknown days and months with 1 in the timestamp.
+func (d *Date) trim(p datePart) {
+	if !d.Valid() {
+		return
+	}
+	switch {
+	case p.Year == nil:
+		d.Precision = PrecisionNone
+	case p.Month == nil:
+		d.Precision = min(d.Precision, PrecisionYear)
+	case p.Day == nil:
+		d.Precision = min(d.Precision, PrecisionMonth)
+	}
+}
+
+type datePart struct {
+	Day   *int `json:"day"`
+	Month *int `json:"month"`
+	Year  *int `json:"year"`
+}
+
+func (d Date) part() datePart {
+	var p datePart
+	if d.Precision >= PrecisionYear {
+		y := d.Year()
+		p.Year = &y
+	}
+	if d.Precision >= PrecisionMonth {
+		m := int(d.Month())
+		p.Month = &m
+	}
+	if d.Precision >= PrecisionDay {
+		day := d.Day()
+		p.Day = &day
+	}
+	return p
+}
+
+type DateRange struct {
+	From   Date   `json:"from"`
+	To     Date   `json:"to"`
+	String string `json:"string"`
+}
+
+type dateRangeJSON struct {
+	From   Date       `json:"from"`
+	To     Date       `json:"to"`
+	Prop   *dateProps `json:"prop"`
+	String string     `json:"string"`
+}
+
+type dateProps struct {
+	From datePart `json:"from"`
+	To   datePart `json:"to"`
+}
+
+// MarshalJSON writes the prop block back out so the precision of partial
+// dates is kept when a DateRange is cached.
+func (r DateRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dateRangeJSON{
+		From:   r.From,
+		To:     r.To,
+		Prop:   &dateProps{From: r.From.part(), To: r.To.part()},
+		String: r.String,
+	})
+}
+
+func (r *DateRange) UnmarshalJSON(b []byte) error {
+	var raw dateRangeJSON
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if raw.Prop != nil {
+		raw.From.trim(raw.Prop.From)
+		raw.To.trim(raw.Prop.To)
+	}
+	*r = DateRange{From: raw.From, To: raw.To, String: raw.String}
+	return nil
+}
@@ -0,0 +1,60 @@
+package jikan_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Sethispr/jikanGo"
+)
+
+func TestDateRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		date jikan.Date
+		json string
+	}{
+		{"year", jikan.Date{Time: time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC), Precision: jikan.PrecisionYear}, `"1998"`},
+		{"month", jikan.Date{Time: time.Date(1998, 4, 1, 0, 0, 0, 0, time.UTC), Precision: jikan.PrecisionMonth}, `"1998-04"`},
+		{"day", jikan.Date{Time: time.Date(1998, 4, 3, 0, 0, 0, 0, time.UTC), Precision: jikan.PrecisionDay}, `"1998-04-03"`},
+		{"time", jikan.Date{Time: time.Date(1998, 4, 3, 15, 30, 0, 0, time.UTC), Precision: jikan.PrecisionTime}, `"1998-04-03T15:30:00Z"`},
+		{"null", jikan.Date{}, `null`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.date)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.json {
+				t.Fatalf("Marshal = %s, want %s", b, tt.json)
+			}
+			var got jikan.Date
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Precision != tt.date.Precision || !got.Time.Equal(tt.date.Time) {
+				t.Errorf("round trip = %v at precision %d, want %v at %d", got.Time, got.Precision, tt.date.Time, tt.date.Precision)
+			}
+			// Decoded dates keep their text and encode it unchanged.
+			again, err := json.Marshal(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(again) != tt.json {
+				t.Errorf("second Marshal = %s, want %s", again, tt.json)
+			}
+		})
+	}
+}
+
+func TestDateKeepsRaw(t *testing.T) {
+	var d jikan.Date
+	if err := json.Unmarshal([]byte(`"1998-04-03T00:00:00+00:00"`), &d); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(d)
+	if string(b) != `"1998-04-03T00:00:00+00:00"` {
+		t.Errorf("Marshal = %s, want the original text", b)
+	}
+}
@@ -0,0 +1,317 @@
+package jikan
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// DriftReport lists the differences between one response body and the
+// model it was decoded into. Unknown holds JSON keys the model has no
+// field for; Missing holds model fields whose key was absent. A key that
+// is present with a null value is not missing. Paths are dotted, with []
+// for array elements, e.g. "data.aired.prop" or "data.genres[].url".
+type DriftReport struct {
+	Endpoint string   `json:"endpoint"`
+	Unknown  []string `json:"unknown,omitempty"`
+	Missing  []string `json:"missing,omitempty"`
+	// Count is how many responses showed this drift. It is 1 for a
+	// single report and summed by DriftCollector.
+	Count int `json:"count"`
+}
+
+// Empty reports whether the body matched the model exactly.
+func (r DriftReport) Empty() bool { return len(r.Unknown) == 0 && len(r.Missing) == 0 }
+
+// DriftError is returned by Client.Do when strict decoding is set to fail
+// requests. The decoded value is still filled in.
+type DriftError struct {
+	Report DriftReport
+}
+
+func (e *DriftError) Error() string {
+	return fmt.Sprintf("schema drift on %s: %d unknown, %d missing fields",
+		e.Report.Endpoint, len(e.Report.Unknown), len(e.Report.Missing))
+}
+
+// WithStrictDecoding compares every decoded response against its model
+// and passes non-empty reports to handle. Requests still succeed unless
+// WithDriftErrors is also given. Cached responses are not checked.
+func WithStrictDecoding(handle func(DriftReport)) Option {
+	return func(c *Client) {
+		c.drift = handle
+	}
+}
+
+// WithDriftErrors makes strict decoding fail requests with a *DriftError.
+func WithDriftErrors() Option {
+	return func(c *Client) {
+		c.driftErrors = true
+	}
+}
+
+// CheckDrift decodes body into v and reports how the two differ. endpoint
+// is only used to label the report; pass a path and it is reduced to its
+// template, so "/anime/1/full" becomes "/anime/{id}/full".
+func CheckDrift(endpoint string, body []byte, v any) (DriftReport, error) {
+	if err := json.Unmarshal(body, v); err != nil {
+		return DriftReport{}, err
+	}
+	return driftOf(endpoint, body, reflect.TypeOf(v))
+}
+
+func driftOf(endpoint string, body []byte, t reflect.Type) (DriftReport, error) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var raw any
+	if err := dec.Decode(&raw); err != nil {
+		return DriftReport{}, err
+	}
+	w := driftWalk{unknown: map[string]bool{}, missing: map[string]bool{}}
+	w.walk(raw, t, "")
+	r := DriftReport{Endpoint: EndpointTemplate(endpoint), Count: 1}
+	r.Unknown = sortedKeys(w.unknown)
+	r.Missing = sortedKeys(w.missing)
+	return r, nil
+}
+
+type driftWalk struct {
+	unknown, missing map[string]bool
+}
+
+var (
+	jsonUnmarshaler = reflect.TypeFor[json.Unmarshaler]()
+	textUnmarshaler = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+func (w *driftWalk) walk(v any, t reflect.Type, path string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	// Types that decode themselves (Date, Optional, the enums) own their
+	// JSON shape, so there is nothing to compare inside them.
+	if pt := reflect.PointerTo(t); pt.Implements(jsonUnmarshaler) || pt.Implements(textUnmarshaler) {
+		return
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return
+		}
+		fields := jsonFields(t)
+		for key, val := range obj {
+			f, ok := fields[key]
+			if !ok {
+				f, ok = foldField(fields, key)
+			}
+			if !ok {
+				w.unknown[join(path, key)] = true
+				continue
+			}
+			w.walk(val, f.typ, join(path, key))
+		}
+		for name, f := range fields {
+			if f.omitEmpty {
+				continue
+			}
+			if _, ok := obj[name]; !ok {
+				if _, ok := foldKey(obj, name); !ok {
+					w.missing[join(path, name)] = true
+				}
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		arr, _ := v.([]any)
+		for _, el := range arr {
+			w.walk(el, t.Elem(), path+"[]")
+		}
+	case reflect.Map:
+		obj, _ := v.(map[string]any)
+		for _, el := range obj {
+			w.walk(el, t.Elem(), join(path, "*"))
+		}
+	}
+}
+
+type jsonField struct {
+	typ       reflect.Type
+	omitEmpty bool
+}
+
+var fieldCache sync.Map // reflect.Type -> map[string]jsonField
+
+// jsonFields returns the JSON keys of a struct following encoding/json's
+// rules for tags and embedded structs.
+func jsonFields(t reflect.Type) map[string]jsonField {
+	if f, ok := fieldCache.Load(t); ok {
+		return f.(map[string]jsonField)
+	}
+	fields := map[string]jsonField{}
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if sf.Anonymous && name == "" {
+			et := sf.Type
+			if et.Kind() == reflect.Pointer {
+				et = et.Elem()
+			}
+			if et.Kind() == reflect.Struct {
+				for k, f := range jsonFields(et) {
+					if _, ok := fields[k]; !ok {
+						fields[k] = f
+					}
+				}
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		fields[name] = jsonField{typ: sf.Type, omitEmpty: strings.Contains(opts, "omitempty")}
+	}
+	fieldCache.Store(t, fields)
+	return fields
+}
+
+func foldField(fields map[string]jsonField, key string) (jsonField, bool) {
+	for name, f := range fields {
+		if strings.EqualFold(name, key) {
+			return f, true
+		}
+	}
+	return jsonField{}, false
+}
+
+func foldKey(obj map[string]any, name string) (any, bool) {
+	for k, v := range obj {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func sortedKeys(m map[string]bool) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	slices.Sort(out)
+	return out
+}
+
+// EndpointTemplate replaces the variable parts of an API path with
+// placeholders so reports for different IDs are grouped together.
+func EndpointTemplate(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	seg := strings.Split(strings.Trim(path, "/"), "/")
+	for i, s := range seg {
+		var prev string
+		if i > 0 {
+			prev = seg[i-1]
+		}
+		switch {
+		case prev == "seasons" && isDigits(s):
+			seg[i] = "{year}"
+		case prev == "{year}":
+			seg[i] = "{season}"
+		case prev == "episodes" && isDigits(s):
+			seg[i] = "{episode}"
+		case isDigits(s):
+			seg[i] = "{id}"
+		case prev == "users" && i == 1 && s != "userbyid":
+			seg[i] = "{username}"
+		}
+	}
+	return "/" + strings.Join(seg, "/")
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// DriftCollector aggregates drift reports per endpoint. Its Record method
+// can be passed straight to WithStrictDecoding.
+type DriftCollector struct {
+	mu      sync.Mutex
+	reports map[string]*driftAgg
+}
+
+type driftAgg struct {
+	count            int
+	unknown, missing map[string]bool
+}
+
+func NewDriftCollector() *DriftCollector {
+	return &DriftCollector{reports: make(map[string]*driftAgg)}
+}
+
+func (d *DriftCollector) Record(r DriftReport) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	a, ok := d.reports[r.Endpoint]
+	if !ok {
+		a = &driftAgg{unknown: map[string]bool{}, missing: map[string]bool{}}
+		d.reports[r.Endpoint] = a
+	}
+	a.count += max(r.Count, 1)
+	for _, p := range r.Unknown {
+		a.unknown[p] = true
+	}
+	for _, p := range r.Missing {
+		a.missing[p] = true
+	}
+}
+
+// Reports returns one merged report per endpoint, sorted by endpoint.
+func (d *DriftCollector) Reports() []DriftReport {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	out := make([]DriftReport, 0, len(d.reports))
+	for ep, a := range d.reports {
+		out = append(out, DriftReport{
+			Endpoint: ep,
+			Unknown:  sortedKeys(a.unknown),
+			Missing:  sortedKeys(a.missing),
+			Count:    a.count,
+		})
+	}
+	slices.SortFunc(out, func(a, b DriftReport) int { return strings.Compare(a.Endpoint, b.Endpoint) })
+	return out
+}
+
+// MarshalJSON exports the merged reports as a JSON array.
+func (d *DriftCollector) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Reports())
+}
@@ -0,0 +1,253 @@
+package jikan
+
+import "strings"
+
+// The enum types below hold the value Jikan expects in query strings
+// (for example AnimeTypeTV is "tv"), while String and MarshalText give the
+// label Jikan uses in responses ("TV"). UnmarshalText accepts either form.
+// Values Jikan adds later are kept verbatim rather than rejected; Known
+// reports whether a value is one of the documented constants.
+
+type enumTable[T ~string] struct {
+	labels map[T]string
+	values map[string]T
+}
+
+func newEnumTable[T ~string](labels map[T]string) enumTable[T] {
+	e := enumTable[T]{labels: labels, values: make(map[string]T, 2*len(labels))}
+	for v, label := range labels {
+		e.values[strings.ToLower(label)] = v
+		e.values[strings.ToLower(string(v))] = v
+	}
+	return e
+}
+
+func (e enumTable[T]) label(v T) string {
+	if s, ok := e.labels[v]; ok {
+		return s
+	}
+	return string(v)
+}
+
+func (e enumTable[T]) parse(s string) T {
+	if v, ok := e.values[strings.ToLower(s)]; ok {
+		return v
+	}
+	return T(s)
+}
+
+func (e enumTable[T]) known(v T) bool {
+	_, ok := e.labels[v]
+	return ok
+}
+
+type AnimeType string
+
+const (
+	AnimeTypeTV        AnimeType = "tv"
+	AnimeTypeMovie     AnimeType = "movie"
+	AnimeTypeOVA       AnimeType = "ova"
+	AnimeTypeSpecial   AnimeType = "special"
+	AnimeTypeONA       AnimeType = "ona"
+	AnimeTypeMusic     AnimeType = "music"
+	AnimeTypeCM        AnimeType = "cm"
+	AnimeTypePV        AnimeType = "pv"
+	AnimeTypeTVSpecial AnimeType = "tv_special"
+)
+
+var animeTypes = newEnumTable(map[AnimeType]string{
+	AnimeTypeTV:        "TV",
+	AnimeTypeMovie:     "Movie",
+	AnimeTypeOVA:       "OVA",
+	AnimeTypeSpecial:   "Special",
+	AnimeTypeONA:       "ONA",
+	AnimeTypeMusic:     "Music",
+	AnimeTypeCM:        "CM",
+	AnimeTypePV:        "PV",
+	AnimeTypeTVSpecial: "TV Special",
+})
+
+func (t AnimeType) String() string                { return animeTypes.label(t) }
+func (t AnimeType) Known() bool                   { return animeTypes.known(t) }
+func (t AnimeType) MarshalText() ([]byte, error)  { return []byte(t.String()), nil }
+func (t *AnimeType) UnmarshalText(b []byte) error { *t = animeTypes.parse(string(b)); return nil }
+
+type AnimeStatus string
+
+const (
+	AnimeStatusAiring   AnimeStatus = "airing"
+	AnimeStatusComplete AnimeStatus = "complete"
+	AnimeStatusUpcoming AnimeStatus = "upcoming"
+)
+
+var animeStatuses = newEnumTable(map[AnimeStatus]string{
+	AnimeStatusAiring:   "Currently Airing",
+	AnimeStatusComplete: "Finished Airing",
+	AnimeStatusUpcoming: "Not yet aired",
+})
+
+func (s AnimeStatus) String() string                { return animeStatuses.label(s) }
+func (s AnimeStatus) Known() bool                   { return animeStatuses.known(s) }
+func (s AnimeStatus) MarshalText() ([]byte, error)  { return []byte(s.String()), nil }
+func (s *AnimeStatus) UnmarshalText(b []byte) error { *s = animeStatuses.parse(string(b)); return nil }
+
+type AnimeRating string
+
+const (
+	RatingG    AnimeRating = "g"
+	RatingPG   AnimeRating = "pg"
+	RatingPG13 AnimeRating = "pg13"
+	RatingR17  AnimeRating = "r17"
+	RatingR    AnimeRating = "r"
+	RatingRx   AnimeRating = "rx"
+)
+
+var animeRatings = newEnumTable(map[AnimeRating]string{
+	RatingG:    "G - All Ages",
+	RatingPG:   "PG - Children",
+	RatingPG13: "PG-13 - Teens 13 or older",
+	RatingR17:  "R - 17+ (violence & profanity)",
+	RatingR:    "R+ - Mild Nudity",
+	RatingRx:   "Rx - Hentai",
+})
+
+func (r AnimeRating) String() string                { return animeRatings.label(r) }
+func (r AnimeRating) Known() bool                   { return animeRatings.known(r) }
+func (r AnimeRating) MarshalText() ([]byte, error)  { return []byte(r.String()), nil }
+func (r *AnimeRating) UnmarshalText(b []byte) error { *r = animeRatings.parse(string(b)); return nil }
+
+// AnimeSource is only reported, never filtered on, so its values are the
+// labels themselves.
+type AnimeSource string
+
+const (
+	SourceOriginal     AnimeSource = "Original"
+	SourceManga        AnimeSource = "Manga"
+	Source4KomaManga   AnimeSource = "4-koma manga"
+	SourceWebManga     AnimeSource = "Web manga"
+	SourceDigitalManga AnimeSource = "Digital manga"
+	SourceNovel        AnimeSource = "Novel"
+	SourceLightNovel   AnimeSource = "Light novel"
+	SourceVisualNovel  AnimeSource = "Visual novel"
+	SourceGame         AnimeSource = "Game"
+	SourceCardGame     AnimeSource = "Card game"
+	SourceBook         AnimeSource = "Book"
+	SourcePictureBook  AnimeSource = "Picture book"
+	SourceRadio        AnimeSource = "Radio"
+	SourceMusic        AnimeSource = "Music"
+	SourceWebNovel     AnimeSource = "Web novel"
+	SourceMixedMedia   AnimeSource = "Mixed media"
+	SourceOther        AnimeSource = "Other"
+	SourceUnknown      AnimeSource = "Unknown"
+)
+
+var animeSources = newEnumTable(map[AnimeSource]string{
+	SourceOriginal:     string(SourceOriginal),
+	SourceManga:        string(SourceManga),
+	Source4KomaManga:   string(Source4KomaManga),
+	SourceWebManga:     string(SourceWebManga),
+	SourceDigitalManga: string(SourceDigitalManga),
+	SourceNovel:        string(SourceNovel),
+	SourceLightNovel:   string(SourceLightNovel),
+	SourceVisualNovel:  string(SourceVisualNovel),
+	SourceGame:         string(SourceGame),
+	SourceCardGame:     string(SourceCardGame),
+	SourceBook:         string(SourceBook),
+	SourcePictureBook:  string(SourcePictureBook),
+	SourceRadio:        string(SourceRadio),
+	SourceMusic:        string(SourceMusic),
+	SourceWebNovel:     string(SourceWebNovel),
+	SourceMixedMedia:   string(SourceMixedMedia),
+	SourceOther:        string(SourceOther),
+	SourceUnknown:      string(SourceUnknown),
+})
+
+func (s AnimeSource) String() string                { return animeSources.label(s) }
+func (s AnimeSource) Known() bool                   { return animeSources.known(s) }
+func (s AnimeSource) MarshalText() ([]byte, error)  { return []byte(s.String()), nil }
+func (s *AnimeSource) UnmarshalText(b []byte) error { *s = animeSources.parse(string(b)); return nil }
+
+type MangaType string
+
+const (
+	MangaTypeManga      MangaType = "manga"
+	MangaTypeNovel      MangaType = "novel"
+	MangaTypeLightNovel MangaType = "lightnovel"
+	MangaTypeOneShot    MangaType = "oneshot"
+	MangaTypeDoujin     MangaType = "doujin"
+	MangaTypeManhwa     MangaType = "manhwa"
+	MangaTypeManhua     MangaType = "manhua"
+	MangaTypeOEL        MangaType = "oel"
+)
+
+var mangaTypes = newEnumTable(map[MangaType]string{
+	MangaTypeManga:      "Manga",
+	MangaTypeNovel:      "Novel",
+	MangaTypeLightNovel: "Light Novel",
+	MangaTypeOneShot:    "One-shot",
+	MangaTypeDoujin:     "Doujinshi",
+	MangaTypeManhwa:     "Manhwa",
+	MangaTypeManhua:     "Manhua",
+	MangaTypeOEL:        "OEL",
+})
+
+func (t MangaType) String() string                { return mangaTypes.label(t) }
+func (t MangaType) Known() bool                   { return mangaTypes.known(t) }
+func (t MangaType) MarshalText() ([]byte, error)  { return []byte(t.String()), nil }
+func (t *MangaType) UnmarshalText(b []byte) error { *t = mangaTypes.parse(string(b)); return nil }
+
+type MangaStatus string
+
+const (
+	MangaStatusPublishing   MangaStatus = "publishing"
+	MangaStatusComplete     MangaStatus = "complete"
+	MangaStatusHiatus       MangaStatus = "hiatus"
+	MangaStatusDiscontinued MangaStatus = "discontinued"
+	MangaStatusUpcoming     MangaStatus = "upcoming"
+)
+
+var mangaStatuses = newEnumTable(map[MangaStatus]string{
+	MangaStatusPublishing:   "Publishing",
+	MangaStatusComplete:     "Finished",
+	MangaStatusHiatus:       "On Hiatus",
+	MangaStatusDiscontinued: "Discontinued",
+	MangaStatusUpcoming:     "Not yet published",
+})
+
+func (s MangaStatus) String() string                { return mangaStatuses.label(s) }
+func (s MangaStatus) Known() bool                   { return mangaStatuses.known(s) }
+func (s MangaStatus) MarshalText() ([]byte, error)  { return []byte(s.String()), nil }
+func (s *MangaStatus) UnmarshalText(b []byte) error { *s = mangaStatuses.parse(string(b)); return nil }
+
+type AnimeOrder string
+
+const (
+	AnimeOrderMalID      AnimeOrder = "mal_id"
+	AnimeOrderTitle      AnimeOrder = "title"
+	AnimeOrderStartDate  AnimeOrder = "start_date"
+	AnimeOrderEndDate    AnimeOrder = "end_date"
+	AnimeOrderEpisodes   AnimeOrder = "episodes"
+	AnimeOrderScore      AnimeOrder = "score"
+	AnimeOrderScoredBy   AnimeOrder = "scored_by"
+	AnimeOrderRank       AnimeOrder = "rank"
+	AnimeOrderPopularity AnimeOrder = "popularity"
+	AnimeOrderMembers    AnimeOrder = "members"
+	AnimeOrderFavorites  AnimeOrder = "favorites"
+)
+
+type MangaOrder string
+
+const (
+	MangaOrderMalID      MangaOrder = "mal_id"
+	MangaOrderTitle      MangaOrder = "title"
+	MangaOrderStartDate  MangaOrder = "start_date"
+	MangaOrderEndDate    MangaOrder = "end_date"
+	MangaOrderChapters   MangaOrder = "chapters"
+	MangaOrderVolumes    MangaOrder = "volumes"
+	MangaOrderScore      MangaOrder = "score"
+	MangaOrderScoredBy   MangaOrder = "scored_by"
+	MangaOrderRank       MangaOrder = "rank"
+	MangaOrderPopularity MangaOrder = "popularity"
+	MangaOrderMembers    MangaOrder = "members"
+	MangaOrderFavorites  MangaOrder = "favorites"
+)
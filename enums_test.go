@@ -0,0 +1,130 @@
+package jikan_test
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Sethispr/jikanGo"
+	"github.com/Sethispr/jikanGo/jikantest"
+)
+
+type enum interface {
+	~string
+	Known() bool
+	encoding.TextMarshaler
+}
+
+// roundTrip checks that each value marshals to its label and that both
+// the label and the query value, in any case, unmarshal back to it.
+func roundTrip[T enum, P interface {
+	*T
+	encoding.TextUnmarshaler
+}](t *testing.T, labels map[T]string) {
+	t.Helper()
+	for v, label := range labels {
+		if !v.Known() {
+			t.Errorf("%q is not Known", v)
+		}
+		b, err := v.MarshalText()
+		if err != nil || string(b) != label {
+			t.Errorf("%q marshals to %q, %v, want %q", v, b, err, label)
+		}
+		for _, in := range []string{label, string(v), strings.ToUpper(label)} {
+			var got T
+			if err := P(&got).UnmarshalText([]byte(in)); err != nil || got != v {
+				t.Errorf("UnmarshalText(%q) = %q, %v, want %q", in, got, err, v)
+			}
+		}
+	}
+}
+
+func TestEnumRoundTrip(t *testing.T) {
+	roundTrip(t, map[jikan.AnimeType]string{
+		jikan.AnimeTypeTV:        "TV",
+		jikan.AnimeTypeMovie:     "Movie",
+		jikan.AnimeTypeTVSpecial: "TV Special",
+	})
+	roundTrip(t, map[jikan.AnimeStatus]string{
+		jikan.AnimeStatusAiring:   "Currently Airing",
+		jikan.AnimeStatusComplete: "Finished Airing",
+		jikan.AnimeStatusUpcoming: "Not yet aired",
+	})
+	roundTrip(t, map[jikan.AnimeRating]string{
+		jikan.RatingPG13: "PG-13 - Teens 13 or older",
+		jikan.RatingR17:  "R - 17+ (violence & profanity)",
+	})
+	roundTrip(t, map[jikan.AnimeSource]string{
+		jikan.SourceLightNovel: "Light novel",
+		jikan.Source4KomaManga: "4-koma manga",
+	})
+	roundTrip(t, map[jikan.MangaType]string{
+		jikan.MangaTypeLightNovel: "Light Novel",
+		jikan.MangaTypeOneShot:    "One-shot",
+	})
+	roundTrip(t, map[jikan.MangaStatus]string{
+		jikan.MangaStatusComplete: "Finished",
+		jikan.MangaStatusHiatus:   "On Hiatus",
+	})
+}
+
+func TestEnumUnknownLabel(t *testing.T) {
+	var a jikan.Anime
+	if err := json.Unmarshal([]byte(`{"type":"Web Series","status":"Finished Airing"}`), &a); err != nil {
+		t.Fatal(err)
+	}
+	if a.Type != "Web Series" || a.Type.Known() {
+		t.Errorf("type is %q, Known %v; want the label kept and not Known", a.Type, a.Type.Known())
+	}
+	if a.Status != jikan.AnimeStatusComplete {
+		t.Errorf("status is %q, want %q", a.Status, jikan.AnimeStatusComplete)
+	}
+	b, err := json.Marshal(struct {
+		Type   jikan.AnimeType   `json:"type"`
+		Status jikan.AnimeStatus `json:"status"`
+	}{a.Type, a.Status})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"type":"Web Series","status":"Finished Airing"}`; string(b) != want {
+		t.Errorf("marshalled %s, want %s", b, want)
+	}
+}
+
+func TestEnumCheckKnown(t *testing.T) {
+	c, srv := jikantest.NewClient(t)
+	ctx := context.Background()
+	tests := []struct {
+		name  string
+		opts  jikan.AnimeSearchOptions
+		field string
+	}{
+		{"unset", jikan.AnimeSearchOptions{}, ""},
+		{"known", jikan.AnimeSearchOptions{Type: jikan.AnimeTypeTV, Status: jikan.AnimeStatusAiring, Rating: jikan.RatingPG13}, ""},
+		{"unknown type", jikan.AnimeSearchOptions{Type: "web"}, "type"},
+		{"label instead of value", jikan.AnimeSearchOptions{Status: "Currently Airing"}, "status"},
+		{"unknown rating", jikan.AnimeSearchOptions{Rating: "nc17"}, "rating"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := len(srv.Requests())
+			_, _, err := c.Search.Anime(ctx, "", tt.opts)
+			if tt.field == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			var ve *jikan.ValidationError
+			if !errors.As(err, &ve) || ve.Field != tt.field {
+				t.Fatalf("got %v, want an invalid %s", err, tt.field)
+			}
+			if len(srv.Requests()) != n {
+				t.Error("request made despite the invalid option")
+			}
+		})
+	}
+}
@@ -1,16 +1,33 @@
 package jikan
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"slices"
+	"strings"
+	"time"
 )
 
+// Sentinel errors for errors.Is. API errors match by status, so
+// errors.Is(err, ErrNotFound) works on anything returned by a service.
+var (
+	ErrNotFound            = errors.New("jikan: not found")
+	ErrRateLimited         = errors.New("jikan: rate limited")
+	ErrUpstreamUnavailable = errors.New("jikan: upstream unavailable")
+	ErrInvalidArgument     = errors.New("jikan: invalid argument")
+)
+
+// Error is an error body returned by Jikan. ReportURL is set on server
+// errors Jikan wants reported upstream.
 type Error struct {
-	Status  int    `json:"status"`
-	Message string `json:"message"`
-	Type    string `json:"type"`
-	Err     string `json:"error"`
+	Status    int    `json:"status"`
+	Message   string `json:"message"`
+	Type      string `json:"type"`
+	Err       string `json:"error"`
+	ReportURL string `json:"report_url,omitempty"`
 }
 
 func (e *Error) Error() string {
@@ -21,6 +38,14 @@ func (e *Error) Error() string {
 }
 
 func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.IsNotFound()
+	case ErrRateLimited:
+		return e.IsRateLimit()
+	case ErrUpstreamUnavailable:
+		return e.IsServerError()
+	}
 	t, ok := target.(*Error)
 	if !ok {
 		return false
@@ -32,7 +57,7 @@ func (e *Error) IsNotFound() bool    { return e.Status == http.StatusNotFound }
 func (e *Error) IsRateLimit() bool   { return e.Status == http.StatusTooManyRequests }
 func (e *Error) IsServerError() bool { return e.Status >= 500 && e.Status < 600 }
 
-func parseError(resp *http.Response) error {
+func parseError(resp *http.Response) *Error {
 	var e Error
 	if err := json.NewDecoder(resp.Body).Decode(&e); err != nil {
 		e.Message = http.StatusText(resp.StatusCode)
@@ -40,3 +65,79 @@ func parseError(resp *http.Response) error {
 	e.Status = resp.StatusCode
 	return &e
 }
+
+// Attempt records one try of a request against one backend. Status is
+// zero when no response came back.
+type Attempt struct {
+	Backend  string
+	Status   int
+	Err      error
+	Duration time.Duration
+}
+
+// RequestError wraps every failure from Client.Do with the request it
+// belongs to. Err is the final cause: an *Error for API errors, or a
+// transport, decoding or context error. Body holds the start of the
+// response when it could not be decoded. Backend is the base URL of the
+// last backend tried.
+type RequestError struct {
+	Method   string
+	Endpoint string
+	Backend  string
+	URL      string
+	Attempts []Attempt
+	Body     string
+	Err      error
+}
+
+const maxSnippet = 512
+
+func snippet(b []byte) string {
+	if len(b) <= maxSnippet {
+		return string(b)
+	}
+	return string(b[:maxSnippet]) + "..."
+}
+
+func (e *RequestError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "jikan: %s %s: %v", e.Method, e.URL, e.Err)
+	if n := len(e.Attempts); n > 1 {
+		if tried := e.backends(); len(tried) > 1 {
+			fmt.Fprintf(&b, " (after %d attempts on %s)", n, strings.Join(tried, ", "))
+		} else {
+			fmt.Fprintf(&b, " (after %d attempts)", n)
+		}
+	}
+	if e.Body != "" {
+		fmt.Fprintf(&b, "; body: %s", e.Body)
+	}
+	return b.String()
+}
+
+// backends lists the distinct backends the attempts went to, in order.
+func (e *RequestError) backends() []string {
+	var tried []string
+	for _, a := range e.Attempts {
+		if a.Backend != "" && !slices.Contains(tried, a.Backend) {
+			tried = append(tried, a.Backend)
+		}
+	}
+	return tried
+}
+
+func (e *RequestError) Unwrap() error { return e.Err }
+
+// Is reports ErrUpstreamUnavailable when no attempt got a response at all.
+// API errors are matched through Err.
+func (e *RequestError) Is(target error) bool {
+	if target != ErrUpstreamUnavailable || len(e.Attempts) == 0 {
+		return false
+	}
+	for _, a := range e.Attempts {
+		if a.Status != 0 || errors.Is(a.Err, context.Canceled) || errors.Is(a.Err, context.DeadlineExceeded) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,177 @@
+package jikan_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/Sethispr/jikanGo"
+	"github.com/Sethispr/jikanGo/jikantest"
+)
+
+func TestRequestErrorStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		sentinel error
+		attempts int
+	}{
+		{"not found", http.StatusNotFound, jikan.ErrNotFound, 1},
+		{"rate limited", http.StatusTooManyRequests, jikan.ErrRateLimited, 2},
+		{"server error", http.StatusInternalServerError, jikan.ErrUpstreamUnavailable, 2},
+		{"unavailable", http.StatusServiceUnavailable, jikan.ErrUpstreamUnavailable, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, srv := jikantest.NewClient(t, jikan.WithRetries(1))
+			srv.AddAnime(jikan.Anime{MalID: 1})
+			srv.ServerError("/anime/1", tt.status, 0)
+
+			_, err := c.Anime.ByID(context.Background(), 1)
+			if !errors.Is(err, tt.sentinel) {
+				t.Fatalf("errors.Is(%v, %v) = false", err, tt.sentinel)
+			}
+			var re *jikan.RequestError
+			if !errors.As(err, &re) {
+				t.Fatalf("%T is not a *RequestError", err)
+			}
+			if re.Method != http.MethodGet || re.Endpoint != "/anime/{id}" {
+				t.Errorf("request = %s %s", re.Method, re.Endpoint)
+			}
+			if want := srv.URL + "/v4"; re.Backend != want {
+				t.Errorf("Backend = %q, want %q", re.Backend, want)
+			}
+			if want := srv.URL + "/v4/anime/1"; re.URL != want {
+				t.Errorf("URL = %q, want %q", re.URL, want)
+			}
+			if len(re.Attempts) != tt.attempts {
+				t.Fatalf("%d attempts, want %d: %+v", len(re.Attempts), tt.attempts, re.Attempts)
+			}
+			for _, a := range re.Attempts {
+				if a.Backend != re.Backend || a.Status != tt.status || a.Err == nil {
+					t.Errorf("attempt = %+v", a)
+				}
+			}
+			if got := len(srv.Requests()); got != tt.attempts {
+				t.Errorf("server saw %d requests, want %d", got, tt.attempts)
+			}
+			if re.Body != "" {
+				t.Errorf("Body = %q for an API error", re.Body)
+			}
+
+			var apiErr *jikan.Error
+			if !errors.As(err, &apiErr) || apiErr.Status != tt.status {
+				t.Fatalf("errors.As *Error = %+v", apiErr)
+			}
+			if apiErr.Type != "BadResponseException" || !strings.Contains(apiErr.Error(), "/anime/1") {
+				t.Errorf("Error = %+v", apiErr)
+			}
+			if got, want := apiErr.ReportURL != "", tt.status >= 500; got != want {
+				t.Errorf("ReportURL = %q", apiErr.ReportURL)
+			}
+			if tt.attempts > 1 && !strings.Contains(err.Error(), "(after 2 attempts)") {
+				t.Errorf("Error() = %q does not count the attempts", err)
+			}
+		})
+	}
+}
+
+func TestRequestErrorRecovers(t *testing.T) {
+	c, srv := jikantest.NewClient(t, jikan.WithRetries(2))
+	srv.AddAnime(jikan.Anime{MalID: 1, Title: "Cowboy Bebop"})
+	srv.ServerError("/anime/1", http.StatusBadGateway, 2)
+
+	a, err := c.Anime.ByID(context.Background(), 1)
+	if err != nil || a.Title != "Cowboy Bebop" {
+		t.Fatalf("ByID = %+v, %v", a, err)
+	}
+	if got := len(srv.Requests()); got != 3 {
+		t.Errorf("server saw %d requests, want 3", got)
+	}
+}
+
+func TestRequestErrorBody(t *testing.T) {
+	c, srv := jikantest.NewClient(t)
+	srv.Malformed("/anime/1", 1)
+
+	_, err := c.Anime.ByID(context.Background(), 1)
+	var re *jikan.RequestError
+	if !errors.As(err, &re) {
+		t.Fatalf("%v is not a *RequestError", err)
+	}
+	var syntax *json.SyntaxError
+	if !errors.As(err, &syntax) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Err = %v, want a decoding error", re.Err)
+	}
+	if re.Body != `{"data": {"mal_id": 1,` {
+		t.Errorf("Body = %q", re.Body)
+	}
+	if len(re.Attempts) != 1 || re.Attempts[0].Status != http.StatusOK || re.Attempts[0].Err != nil {
+		t.Errorf("Attempts = %+v", re.Attempts)
+	}
+	if errors.Is(err, jikan.ErrUpstreamUnavailable) {
+		t.Error("a decoding error matches ErrUpstreamUnavailable")
+	}
+
+	long := `{"data": "` + strings.Repeat("x", 600)
+	srv.Inject(jikantest.Fault{Path: "/anime/2", Body: long, Times: 1})
+	_, err = c.Anime.ByID(context.Background(), 2)
+	if !errors.As(err, &re) {
+		t.Fatalf("%v is not a *RequestError", err)
+	}
+	if want := long[:512] + "..."; re.Body != want {
+		t.Errorf("Body is %d bytes, want the first 512 and an ellipsis", len(re.Body))
+	}
+}
+
+func TestRequestErrorNoResponse(t *testing.T) {
+	srv := jikantest.NewServer()
+	c := srv.Client(jikan.WithRetries(1))
+	srv.Close()
+
+	_, err := c.Anime.ByID(context.Background(), 1)
+	if !errors.Is(err, jikan.ErrUpstreamUnavailable) {
+		t.Fatalf("errors.Is(%v, ErrUpstreamUnavailable) = false", err)
+	}
+	var re *jikan.RequestError
+	if !errors.As(err, &re) || len(re.Attempts) != 2 {
+		t.Fatalf("RequestError = %+v", re)
+	}
+	for _, a := range re.Attempts {
+		if a.Status != 0 || a.Err == nil {
+			t.Errorf("attempt = %+v", a)
+		}
+	}
+}
+
+// TestRateLimitRetries checks that every attempt waits on the limiter, not
+// just the first: a burst of three tokens that never refills is spent by
+// one call that is retried twice.
+func TestRateLimitRetries(t *testing.T) {
+	lim := rate.NewLimiter(rate.Every(time.Hour), 3)
+	c, srv := jikantest.NewClient(t, jikan.WithRateLimiter(lim), jikan.WithRetries(2))
+	srv.AddAnime(jikan.Anime{MalID: 1})
+	srv.ServerError("/anime/1", http.StatusInternalServerError, 2)
+
+	if _, err := c.Anime.ByID(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if tokens := lim.Tokens(); tokens >= 1 {
+		t.Errorf("%.0f tokens left after three attempts, want 0", tokens)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err := c.Anime.ByID(ctx, 1)
+	var re *jikan.RequestError
+	if !errors.As(err, &re) || len(re.Attempts) != 0 {
+		t.Fatalf("ByID with no tokens left = %v, want a limiter error before any attempt", err)
+	}
+}
@@ -69,12 +69,7 @@ func showByID(ctx context.Context, c *jikan.Client, id jikan.ID) {
 }
 
 func searchAndShow(ctx context.Context, c *jikan.Client, query string) {
-	results, _, err := c.Search.Anime(ctx, query, struct {
-		Type, Status, Rating string
-		Genres               []int
-		OrderBy, Sort        string
-		Page, Limit          int
-	}{Limit: 5})
+	results, _, err := c.Search.Anime(ctx, query, jikan.AnimeSearchOptions{Limit: 5})
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -97,28 +92,7 @@ func searchAndShow(ctx context.Context, c *jikan.Client, query string) {
 	}
 }
 
-func printAnime(a *jikan.Anime, stats *struct {
-	Watching    int `json:"watching"`
-	Completed   int `json:"completed"`
-	OnHold      int `json:"on_hold"`
-	Dropped     int `json:"dropped"`
-	PlanToWatch int `json:"plan_to_watch"`
-	Total       int `json:"total"`
-	Scores      []struct {
-		Score      int     `json:"score"`
-		Votes      int     `json:"votes"`
-		Percentage float64 `json:"percentage"`
-	} `json:"scores"`
-}, rels []struct {
-	Relation string           `json:"relation"`
-	Entry    []jikan.Resource `json:"entry"`
-}, themes *struct {
-	Openings []string `json:"openings"`
-	Endings  []string `json:"endings"`
-}, ext []struct {
-	Name string `json:"name"`
-	URL  string `json:"url"`
-}) {
+func printAnime(a *jikan.Anime, stats *jikan.AnimeStats, rels []jikan.Relation, themes *jikan.AnimeThemes, ext []jikan.ExternalLink) {
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintf(w, "Title:\t%s\n", a.Title)
@@ -126,11 +100,11 @@ func printAnime(a *jikan.Anime, stats *struct {
 		fmt.Fprintf(w, "English:\t%s\n", a.TitleEnglish)
 	}
 	fmt.Fprintf(w, "Type:\t%s\n", a.Type)
-	fmt.Fprintf(w, "Episodes:\t%d\n", a.Episodes)
+	fmt.Fprintf(w, "Episodes:\t%d\n", a.Episodes.ValueOr(0))
 	fmt.Fprintf(w, "Status:\t%s\n", a.Status)
-	fmt.Fprintf(w, "Score:\t%.2f\n", a.Score)
-	fmt.Fprintf(w, "Rank:\t#%d\n", a.Rank)
-	fmt.Fprintf(w, "Popularity:\t#%d\n", a.Popularity)
+	fmt.Fprintf(w, "Score:\t%.2f\n", a.Score.ValueOr(0))
+	fmt.Fprintf(w, "Rank:\t#%d\n", a.Rank.ValueOr(0))
+	fmt.Fprintf(w, "Popularity:\t#%d\n", a.Popularity.ValueOr(0))
 	if len(a.Studios) > 0 {
 		fmt.Fprintf(w, "Studios:\t%s\n", join(a.Studios))
 	}
@@ -76,7 +76,7 @@ func searchCharacters(ctx context.Context, c *jikan.Client, query string, page i
 			kanji = "-"
 		}
 		name := truncate(char.Name, 35)
-		fmt.Fprintf(w, "%d\t%s\t%s\t%d\n", char.MalID, name, kanji, char.Favorites)
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\n", char.MalID, name, kanji, char.Favorites.ValueOr(0))
 	}
 	w.Flush()
 
@@ -138,7 +138,7 @@ func printCharacter(
 		fmt.Fprintf(w, "Nicknames:\t%s\n", strings.Join(char.Nicknames, ", "))
 	}
 	fmt.Fprintf(w, "ID:\t%d\n", char.MalID)
-	fmt.Fprintf(w, "Favorites:\t%d\n", char.Favorites)
+	fmt.Fprintf(w, "Favorites:\t%d\n", char.Favorites.ValueOr(0))
 	fmt.Fprintf(w, "URL:\t%s\n", char.URL)
 	if char.Images.JPG.Medium != "" {
 		fmt.Fprintf(w, "Image:\t%s\n", char.Images.JPG.Medium)
@@ -109,8 +109,8 @@ func printUser(u *jikan.User, s *jikan.UserStatistics) {
 	if s != nil {
 		fmt.Println("\nAnime Stats")
 		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintf(w, "Days Watched:\t%.1f\n", s.Anime.DaysWatched)
-		fmt.Fprintf(w, "Mean Score:\t%.2f\n", s.Anime.MeanScore)
+		fmt.Fprintf(w, "Days Watched:\t%.1f\n", s.Anime.DaysWatched.ValueOr(0))
+		fmt.Fprintf(w, "Mean Score:\t%.2f\n", s.Anime.MeanScore.ValueOr(0))
 		fmt.Fprintf(w, "Watching:\t%d\n", s.Anime.Watching)
 		fmt.Fprintf(w, "Completed:\t%d\n", s.Anime.Completed)
 		fmt.Fprintf(w, "On Hold:\t%d\n", s.Anime.OnHold)
@@ -122,8 +122,8 @@ func printUser(u *jikan.User, s *jikan.UserStatistics) {
 
 		fmt.Println("\nManga Stats")
 		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintf(w, "Days Read:\t%.1f\n", s.Manga.DaysRead)
-		fmt.Fprintf(w, "Mean Score:\t%.2f\n", s.Manga.MeanScore)
+		fmt.Fprintf(w, "Days Read:\t%.1f\n", s.Manga.DaysRead.ValueOr(0))
+		fmt.Fprintf(w, "Mean Score:\t%.2f\n", s.Manga.MeanScore.ValueOr(0))
 		fmt.Fprintf(w, "Reading:\t%d\n", s.Manga.Reading)
 		fmt.Fprintf(w, "Completed:\t%d\n", s.Manga.Completed)
 		fmt.Fprintf(w, "On Hold:\t%d\n", s.Manga.OnHold)
@@ -318,18 +318,18 @@ func joinResources(r []jikan.Resource, limit int) string {
 	return strings.Join(names, ", ")
 }
 
-func nullInt(n *int) string {
-	if n == nil {
+func nullInt(n jikan.Optional[int]) string {
+	if !n.Valid {
 		return "?"
 	}
-	return strconv.Itoa(*n)
+	return strconv.Itoa(n.Value)
 }
 
-func nullFloat(n *float64) string {
-	if n == nil {
+func nullFloat(n jikan.Optional[float64]) string {
+	if !n.Valid {
 		return "?"
 	}
-	return fmt.Sprintf("%.2f", *n)
+	return fmt.Sprintf("%.2f", n.Value)
 }
 
 func truncate(s string, maxLen int) string {
@@ -0,0 +1,13 @@
+package jikan
+
+import "time"
+
+// BackendCooldown exposes the base cooldown to the external tests.
+const BackendCooldown = backendCooldown
+
+// SetNow replaces the clock backend cooldowns are measured with until the
+// returned function is called.
+func SetNow(f func() time.Time) (restore func()) {
+	now = f
+	return func() { now = time.Now }
+}
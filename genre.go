@@ -2,6 +2,7 @@ package jikan
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -28,14 +29,69 @@ const (
 )
 
 func (s *GenreService) Anime(ctx context.Context, filter GenreFilter, page, limit int) ([]*Genre, *Pagination, error) {
+	if err := validate(checkEnum("filter", filter, GenreGenres, GenreExplicit, GenreThemes, GenreDemographics), checkPage(page), checkLimit(limit)); err != nil {
+		return nil, nil, err
+	}
 	return s.list(ctx, "/genres/anime", filter, page, limit)
 }
 
 func (s *GenreService) Manga(ctx context.Context, filter GenreFilter, page, limit int) ([]*Genre, *Pagination, error) {
+	if err := validate(checkEnum("filter", filter, GenreGenres, GenreExplicit, GenreThemes, GenreDemographics), checkPage(page), checkLimit(limit)); err != nil {
+		return nil, nil, err
+	}
 	return s.list(ctx, "/genres/manga", filter, page, limit)
 }
 
+func (s *GenreService) AnimeAll(ctx context.Context, filter GenreFilter, limit int, pageOpts ...PageOption) iter.Seq2[*Genre, error] {
+	if err := validate(checkEnum("filter", filter, GenreGenres, GenreExplicit, GenreThemes, GenreDemographics), checkLimit(limit)); err != nil {
+		return failed[*Genre](err)
+	}
+	return s.all(ctx, "/genres/anime", filter, limit, pageOpts)
+}
+
+func (s *GenreService) MangaAll(ctx context.Context, filter GenreFilter, limit int, pageOpts ...PageOption) iter.Seq2[*Genre, error] {
+	if err := validate(checkEnum("filter", filter, GenreGenres, GenreExplicit, GenreThemes, GenreDemographics), checkLimit(limit)); err != nil {
+		return failed[*Genre](err)
+	}
+	return s.all(ctx, "/genres/manga", filter, limit, pageOpts)
+}
+
+// list fetches a genre list. Jikan sends the whole list in one response
+// without pagination, so the returned Pagination is nil unless Jikan
+// starts paginating it.
 func (s *GenreService) list(ctx context.Context, endpoint string, filter GenreFilter, page, limit int) ([]*Genre, *Pagination, error) {
+	if err := validate(checkEnum("filter", filter, GenreGenres, GenreExplicit, GenreThemes, GenreDemographics), checkPage(page), checkLimit(limit)); err != nil {
+		return nil, nil, err
+	}
+	var r struct {
+		Data       []*Genre    `json:"data"`
+		Pagination *Pagination `json:"pagination,omitempty"`
+	}
+	if err := s.c.Do(ctx, http.MethodGet, endpoint, genreQuery(filter, page, limit), &r); err != nil {
+		return nil, nil, err
+	}
+	return r.Data, r.Pagination, nil
+}
+
+// all yields a genre list from its single response. Of the page options
+// only MaxItems applies, as there are no further pages to walk.
+func (s *GenreService) all(ctx context.Context, endpoint string, filter GenreFilter, limit int, opts []PageOption) iter.Seq2[*Genre, error] {
+	cfg := newPageConfig(opts)
+	return func(yield func(*Genre, error) bool) {
+		gs, _, err := s.list(ctx, endpoint, filter, 0, limit)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		for i, g := range gs {
+			if cfg.maxItems > 0 && i == cfg.maxItems || !yield(g, nil) {
+				return
+			}
+		}
+	}
+}
+
+func genreQuery(filter GenreFilter, page, limit int) url.Values {
 	q := url.Values{}
 	if filter != "" {
 		q.Set("filter", string(filter))
@@ -46,13 +102,12 @@ func (s *GenreService) list(ctx context.Context, endpoint string, filter GenreFi
 	if limit > 0 {
 		q.Set("limit", strconv.Itoa(limit))
 	}
+	return q
+}
 
-	var r struct {
-		Data       []*Genre   `json:"data"`
-		Pagination Pagination `json:"pagination"`
-	}
-	if err := s.c.Do(ctx, http.MethodGet, endpoint, q, &r); err != nil {
-		return nil, nil, err
-	}
-	return r.Data, &r.Pagination, nil
+// byIDs resolves ids with a single walk over the "anime" or "manga" genre
+// list.
+func (s *GenreService) byIDs(ctx context.Context, list string, ids []ID) *BatchResult[Genre] {
+	all := s.all(ctx, "/genres/"+list, "", 0, nil)
+	return fromList(all, func(g *Genre) ID { return g.MalID }, ids, false, list+" genre")
 }
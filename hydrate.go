@@ -0,0 +1,207 @@
+package jikan
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type ResourceKind string
+
+const (
+	KindAnime     ResourceKind = "anime"
+	KindManga     ResourceKind = "manga"
+	KindCharacter ResourceKind = "character"
+	KindPerson    ResourceKind = "people"
+	KindProducer  ResourceKind = "producer"
+	KindMagazine  ResourceKind = "magazine"
+	KindGenre     ResourceKind = "genre"
+	KindClub      ResourceKind = "club"
+)
+
+// Kind works out what a Resource points at. Jikan reports studios, genres
+// and magazines with the type of the list they belong to ("anime" or
+// "manga"), so the MAL URL is checked first. Type is used next, and the
+// first URL segment covers nested references that carry no type at all.
+func (r Resource) Kind() ResourceKind {
+	var parts []string
+	if u, err := url.Parse(r.URL); err == nil {
+		parts = strings.Split(strings.Trim(u.Path, "/"), "/")
+	}
+	if len(parts) >= 2 {
+		switch parts[1] {
+		case "producer":
+			return KindProducer
+		case "magazine":
+			return KindMagazine
+		case "genre":
+			return KindGenre
+		}
+	}
+	if k := kindOf(r.Type); k != "" {
+		return k
+	}
+	if len(parts) >= 1 {
+		if k := kindOf(parts[0]); k != "" {
+			return k
+		}
+	}
+	return ResourceKind(r.Type)
+}
+
+func kindOf(s string) ResourceKind {
+	switch strings.ToLower(s) {
+	case "anime":
+		return KindAnime
+	case "manga":
+		return KindManga
+	case "character", "characters":
+		return KindCharacter
+	case "people", "person":
+		return KindPerson
+	case "producer", "producers":
+		return KindProducer
+	case "magazine", "magazines":
+		return KindMagazine
+	case "club", "clubs":
+		return KindClub
+	}
+	return ""
+}
+
+// genreList is the genre list, "anime" or "manga", a genre reference
+// belongs to. Anime and manga genres are numbered separately.
+func (r Resource) genreList() string {
+	list := r.Type
+	if u, err := url.Parse(r.URL); err == nil && u.Path != "" {
+		list, _, _ = strings.Cut(strings.Trim(u.Path, "/"), "/")
+	}
+	if kindOf(list) == KindManga {
+		return "manga"
+	}
+	return "anime"
+}
+
+// Hydrated holds the full entities behind a set of Resource values, split
+// by kind. Resources that could not be resolved are listed in Errors.
+type Hydrated struct {
+	Anime       map[ID]*Anime
+	Manga       map[ID]*Manga
+	Characters  map[ID]*Character
+	People      map[ID]*Person
+	Producers   map[ID]*Producer
+	Magazines   map[ID]*Magazine
+	AnimeGenres map[ID]*Genre
+	MangaGenres map[ID]*Genre
+	Clubs       map[ID]*Club
+	Errors      map[Resource]error
+}
+
+// Lookup returns the hydrated entity for r, typed as one of *Anime, *Manga,
+// *Character, *Person, *Producer, *Magazine, *Genre or *Club.
+func (h *Hydrated) Lookup(r Resource) (any, bool) {
+	var (
+		v  any
+		ok bool
+	)
+	switch r.Kind() {
+	case KindAnime:
+		v, ok = h.Anime[r.MalID]
+	case KindManga:
+		v, ok = h.Manga[r.MalID]
+	case KindCharacter:
+		v, ok = h.Characters[r.MalID]
+	case KindPerson:
+		v, ok = h.People[r.MalID]
+	case KindProducer:
+		v, ok = h.Producers[r.MalID]
+	case KindMagazine:
+		v, ok = h.Magazines[r.MalID]
+	case KindGenre:
+		if r.genreList() == "manga" {
+			v, ok = h.MangaGenres[r.MalID]
+		} else {
+			v, ok = h.AnimeGenres[r.MalID]
+		}
+	case KindClub:
+		v, ok = h.Clubs[r.MalID]
+	}
+	return v, ok
+}
+
+// hydrateGroup is a set of references resolved together: one kind, and
+// for genres one of the two genre lists.
+type hydrateGroup struct {
+	kind ResourceKind
+	list string
+}
+
+func groupOf(r Resource) hydrateGroup {
+	g := hydrateGroup{kind: r.Kind()}
+	if g.kind == KindGenre {
+		g.list = r.genreList()
+	}
+	return g
+}
+
+// Hydrate resolves refs into full entities. IDs are grouped by kind and
+// fetched through the ByIDs helpers, so duplicates cost one request and
+// cached entries cost none. Magazines and genres have no by-ID route and
+// are picked out of the /magazines and /genres lists instead.
+func (c *Client) Hydrate(ctx context.Context, refs []Resource, opts BatchOptions) *Hydrated {
+	h := &Hydrated{Errors: make(map[Resource]error)}
+	ids := make(map[hydrateGroup][]ID)
+	for _, r := range refs {
+		g := groupOf(r)
+		ids[g] = append(ids[g], r.MalID)
+	}
+
+	errs := make(map[hydrateGroup]map[ID]error)
+	for g, list := range ids {
+		switch g.kind {
+		case KindAnime:
+			res := c.Anime.ByIDs(ctx, list, opts)
+			h.Anime, errs[g] = res.Items, res.Errors
+		case KindManga:
+			res := c.Manga.ByIDs(ctx, list, opts)
+			h.Manga, errs[g] = res.Items, res.Errors
+		case KindCharacter:
+			res := c.Character.ByIDs(ctx, list, opts)
+			h.Characters, errs[g] = res.Items, res.Errors
+		case KindPerson:
+			res := c.People.ByIDs(ctx, list, opts)
+			h.People, errs[g] = res.Items, res.Errors
+		case KindProducer:
+			res := c.Producer.ByIDs(ctx, list, opts)
+			h.Producers, errs[g] = res.Items, res.Errors
+		case KindMagazine:
+			res := c.Magazine.byIDs(ctx, list)
+			h.Magazines, errs[g] = res.Items, res.Errors
+		case KindGenre:
+			res := c.Genre.byIDs(ctx, g.list, list)
+			if g.list == "manga" {
+				h.MangaGenres = res.Items
+			} else {
+				h.AnimeGenres = res.Items
+			}
+			errs[g] = res.Errors
+		case KindClub:
+			res := c.Club.ByIDs(ctx, list, opts)
+			h.Clubs, errs[g] = res.Items, res.Errors
+		}
+	}
+
+	for _, r := range refs {
+		g := groupOf(r)
+		m, ok := errs[g]
+		if !ok {
+			h.Errors[r] = fmt.Errorf("cannot hydrate %s resource %d", g.kind, r.MalID)
+			continue
+		}
+		if err, ok := m[r.MalID]; ok {
+			h.Errors[r] = err
+		}
+	}
+	return h
+}
@@ -0,0 +1,123 @@
+package jikan_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Sethispr/jikanGo"
+	"github.com/Sethispr/jikanGo/jikantest"
+)
+
+func ref(kind string, id jikan.ID, url string) jikan.Resource {
+	return jikan.Resource{MalID: id, Type: kind, URL: url}
+}
+
+var hydrateRefs = []jikan.Resource{
+	ref("anime", 1, "https://myanimelist.net/anime/1"),
+	ref("anime", 2, "https://myanimelist.net/anime/2"),
+	ref("anime", 1, "https://myanimelist.net/anime/1"),
+	ref("anime", 404, "https://myanimelist.net/anime/404"),
+	ref("manga", 5, "https://myanimelist.net/manga/5"),
+	ref("character", 7, "https://myanimelist.net/character/7"),
+	ref("manga", 9, "https://myanimelist.net/manga/magazine/9/Afternoon"),
+	ref("manga", 1, "https://myanimelist.net/manga/magazine/1/Big_Comic"),
+	ref("manga", 4, "https://myanimelist.net/manga/magazine/4/Gone"),
+	ref("anime", 1, "https://myanimelist.net/anime/genre/1/Action"),
+	ref("manga", 1, "https://myanimelist.net/manga/genre/1/Action"),
+	ref("manga", 99, "https://myanimelist.net/manga/genre/99/Gone"),
+	ref("playlist", 3, ""),
+}
+
+func newHydrateClient(t *testing.T, opts ...jikan.Option) (*jikan.Client, *jikantest.Server) {
+	t.Helper()
+	c, srv := jikantest.NewClient(t, opts...)
+	srv.AddAnime(jikan.Anime{MalID: 1, Title: "Cowboy Bebop"}, jikan.Anime{MalID: 2})
+	srv.AddManga(jikan.Manga{MalID: 5})
+	srv.AddCharacter(jikan.Character{MalID: 7})
+	srv.AddMagazine(jikan.Magazine{MalID: 9, Name: "Afternoon"}, jikan.Magazine{MalID: 1, Name: "Big Comic"}, jikan.Magazine{MalID: 3})
+	srv.AddGenre("anime", jikan.Genre{MalID: 1, Name: "Action", Count: 5000}, jikan.Genre{MalID: 2, Name: "Adventure"})
+	srv.AddGenre("manga", jikan.Genre{MalID: 1, Name: "Action", Count: 8000})
+	return c, srv
+}
+
+// count returns how many requests went to paths starting with prefix.
+func count(srv *jikantest.Server, prefix string) int {
+	n := 0
+	for _, r := range srv.Requests() {
+		if strings.HasPrefix(r, prefix) {
+			n++
+		}
+	}
+	return n
+}
+
+func TestHydrate(t *testing.T) {
+	c, srv := newHydrateClient(t)
+	srv.ServerError("/characters/*", http.StatusInternalServerError, 0)
+	h := c.Hydrate(context.Background(), hydrateRefs, jikan.BatchOptions{})
+
+	if len(h.Anime) != 2 || h.Anime[1].Title != "Cowboy Bebop" {
+		t.Errorf("anime are %v", h.Anime)
+	}
+	if len(h.Manga) != 1 || len(h.Magazines) != 2 || len(h.AnimeGenres) != 1 || len(h.MangaGenres) != 1 {
+		t.Errorf("got %d manga, %d magazines, %d anime and %d manga genres, want 1, 2, 1, 1",
+			len(h.Manga), len(h.Magazines), len(h.AnimeGenres), len(h.MangaGenres))
+	}
+	if v, ok := h.Lookup(hydrateRefs[6]); !ok || v.(*jikan.Magazine).Name != "Afternoon" {
+		t.Errorf("Lookup(magazine 9) = %v, %v", v, ok)
+	}
+	if v, ok := h.Lookup(hydrateRefs[9]); !ok || v.(*jikan.Genre).Count != 5000 {
+		t.Errorf("Lookup(anime genre 1) = %v, %v", v, ok)
+	}
+	if v, ok := h.Lookup(hydrateRefs[10]); !ok || v.(*jikan.Genre).Count != 8000 {
+		t.Errorf("Lookup(manga genre 1) = %v, %v", v, ok)
+	}
+
+	want := map[jikan.Resource]error{
+		hydrateRefs[3]:  jikan.ErrNotFound,
+		hydrateRefs[5]:  jikan.ErrUpstreamUnavailable,
+		hydrateRefs[8]:  jikan.ErrNotFound,
+		hydrateRefs[11]: jikan.ErrNotFound,
+		hydrateRefs[12]: nil,
+	}
+	if len(h.Errors) != len(want) {
+		t.Errorf("got %d errors, want %d: %v", len(h.Errors), len(want), h.Errors)
+	}
+	for r, target := range want {
+		err, ok := h.Errors[r]
+		if !ok || target != nil && !errors.Is(err, target) {
+			t.Errorf("error for %s %d is %v, want %v", r.Kind(), r.MalID, err, target)
+		}
+	}
+
+	for prefix, n := range map[string]int{
+		"/v4/anime/1":      1,
+		"/v4/magazines?":   1,
+		"/v4/genres/anime": 1,
+		"/v4/genres/manga": 1,
+	} {
+		if got := count(srv, prefix); got != n {
+			t.Errorf("made %d requests to %s, want %d", got, prefix, n)
+		}
+	}
+}
+
+func TestHydrateCached(t *testing.T) {
+	c, srv := newHydrateClient(t, jikan.WithCache(jikan.NewMemoryCache(), time.Minute))
+	ctx := context.Background()
+	first := c.Hydrate(ctx, hydrateRefs, jikan.BatchOptions{})
+	n := len(srv.Requests())
+	second := c.Hydrate(ctx, hydrateRefs, jikan.BatchOptions{})
+	// Only the failed lookups are repeated.
+	if got := len(srv.Requests()) - n; got != 1 {
+		t.Errorf("second Hydrate made %d requests, want 1 for anime 404", got)
+	}
+	if len(second.Errors) != len(first.Errors) || len(second.Anime) != len(first.Anime) {
+		t.Errorf("second Hydrate got %d errors and %d anime, first %d and %d",
+			len(second.Errors), len(second.Anime), len(first.Errors), len(first.Anime))
+	}
+}
@@ -0,0 +1,396 @@
+// Command genapi generates the service interfaces in api_gen.go, the
+// recording fakes in jikantest/fakes_gen.go and the endpoint table in
+// jikantest/routes_gen.go from the exported methods of the *Service types
+// hanging off jikan.Client. Run it through go generate from the module
+// root.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const header = "// Code generated by genapi. DO NOT EDIT.\n\n"
+
+type param struct {
+	name string
+	typ  ast.Expr
+}
+
+type method struct {
+	name    string
+	doc     string
+	params  []param
+	results []ast.Expr
+	decl    *ast.FuncDecl
+}
+
+type service struct {
+	field   string // Client field, e.g. "Anime"
+	typ     string // concrete type, e.g. "AnimeService"
+	methods []method
+	helpers map[string]*ast.FuncDecl // unexported methods
+}
+
+func (s service) iface() string { return s.field + "API" }
+func (s service) fake() string  { return "Fake" + s.field }
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the jikan package")
+	flag.Parse()
+
+	services, err := load(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := write(filepath.Join(*dir, "api_gen.go"), genInterfaces(services)); err != nil {
+		log.Fatal(err)
+	}
+	if err := write(filepath.Join(*dir, "jikantest", "fakes_gen.go"), genFakes(services)); err != nil {
+		log.Fatal(err)
+	}
+	rs, err := routes(services)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := write(filepath.Join(*dir, "jikantest", "routes_gen.go"), genRoutes(rs)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func write(path string, src []byte) error {
+	out, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w\n%s", path, err, src)
+	}
+	return os.WriteFile(path, out, 0o644)
+}
+
+// load collects the services in Client field order along with their
+// exported methods in source order.
+func load(dir string) ([]service, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != "api_gen.go"
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	pkg, ok := pkgs["jikan"]
+	if !ok {
+		return nil, fmt.Errorf("no jikan package in %s", dir)
+	}
+	names := make([]string, 0, len(pkg.Files))
+	for name := range pkg.Files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var services []service
+	byType := map[string]*service{}
+	for _, name := range names {
+		for _, decl := range pkg.Files[name].Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				st, ok := ts.Type.(*ast.StructType)
+				if ts.Name.Name != "Client" || !ok {
+					continue
+				}
+				for _, f := range st.Fields.List {
+					star, ok := f.Type.(*ast.StarExpr)
+					if !ok || len(f.Names) != 1 || !f.Names[0].IsExported() {
+						continue
+					}
+					id, ok := star.X.(*ast.Ident)
+					if !ok || !strings.HasSuffix(id.Name, "Service") {
+						continue
+					}
+					services = append(services, service{field: f.Names[0].Name, typ: id.Name})
+				}
+			}
+		}
+	}
+	for i := range services {
+		byType[services[i].typ] = &services[i]
+	}
+
+	for _, name := range names {
+		for _, decl := range pkg.Files[name].Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil {
+				continue
+			}
+			star, ok := fd.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			id, ok := star.X.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			s, ok := byType[id.Name]
+			if !ok {
+				continue
+			}
+			if !fd.Name.IsExported() {
+				if s.helpers == nil {
+					s.helpers = map[string]*ast.FuncDecl{}
+				}
+				s.helpers[fd.Name.Name] = fd
+				continue
+			}
+			m := method{name: fd.Name.Name, decl: fd}
+			if fd.Doc != nil {
+				m.doc = fd.Doc.Text()
+			}
+			for i, f := range fd.Type.Params.List {
+				if len(f.Names) == 0 {
+					m.params = append(m.params, param{fmt.Sprintf("p%d", i), f.Type})
+				}
+				for _, n := range f.Names {
+					m.params = append(m.params, param{n.Name, f.Type})
+				}
+			}
+			if fd.Type.Results != nil {
+				for _, f := range fd.Type.Results.List {
+					for range max(len(f.Names), 1) {
+						m.results = append(m.results, f.Type)
+					}
+				}
+			}
+			s.methods = append(s.methods, m)
+		}
+	}
+	return services, nil
+}
+
+func genInterfaces(services []service) []byte {
+	var b bytes.Buffer
+	for _, s := range services {
+		fmt.Fprintf(&b, "// %s is the method set of %s.\ntype %s interface {\n", s.iface(), s.typ, s.iface())
+		for _, m := range s.methods {
+			writeDoc(&b, m.doc)
+			fmt.Fprintf(&b, "%s%s\n", m.name, signature(m, asIs))
+		}
+		b.WriteString("}\n\n")
+		fmt.Fprintf(&b, "var _ %s = (*%s)(nil)\n\n", s.iface(), s.typ)
+	}
+	return prepend("package jikan", b.Bytes())
+}
+
+func genFakes(services []service) []byte {
+	var b bytes.Buffer
+
+	b.WriteString("// FakeAPI is an in-memory jikan.API built from one fake per service.\ntype FakeAPI struct {\n")
+	for _, s := range services {
+		fmt.Fprintf(&b, "%s *%s\n", s.field, s.fake())
+	}
+	b.WriteString("}\n\n// NewFakeAPI returns a FakeAPI with every service fake allocated.\nfunc NewFakeAPI() *FakeAPI {\nreturn &FakeAPI{\n")
+	for _, s := range services {
+		fmt.Fprintf(&b, "%s: new(%s),\n", s.field, s.fake())
+	}
+	b.WriteString("}\n}\n\n")
+	for _, s := range services {
+		fmt.Fprintf(&b, "func (f *FakeAPI) %s() jikan.%s { return f.%s }\n", s.iface(), s.iface(), s.field)
+	}
+	b.WriteString("\nvar _ jikan.API = (*FakeAPI)(nil)\n\n")
+
+	for _, s := range services {
+		fmt.Fprintf(&b, "// %s is an in-memory jikan.%s. Each method records its arguments in\n", s.fake(), s.iface())
+		fmt.Fprintf(&b, "// the matching Calls slice and returns what the matching Func field\n// returns, or zero values when it is nil.\n")
+		fmt.Fprintf(&b, "type %s struct {\nmu sync.Mutex\n\n", s.fake())
+		for _, m := range s.methods {
+			fmt.Fprintf(&b, "%sFunc func%s\n", m.name, signature(m, qualify))
+			fmt.Fprintf(&b, "%sCalls []%s%sCall\n", m.name, s.fake(), m.name)
+		}
+		b.WriteString("}\n\n")
+		fmt.Fprintf(&b, "var _ jikan.%s = (*%s)(nil)\n\n", s.iface(), s.fake())
+
+		for _, m := range s.methods {
+			call := s.fake() + m.name + "Call"
+			fmt.Fprintf(&b, "// %s holds the arguments of one %s.%s call.\ntype %s struct {\n", call, s.fake(), m.name, call)
+			for _, p := range m.params {
+				fmt.Fprintf(&b, "%s %s\n", exportName(p.name), fieldType(qualify(p.typ)))
+			}
+			b.WriteString("}\n\n")
+
+			fmt.Fprintf(&b, "func (f *%s) %s%s {\n", s.fake(), m.name, signature(m, qualify))
+			fmt.Fprintf(&b, "f.mu.Lock()\nf.%sCalls = append(f.%sCalls, %s{", m.name, m.name, call)
+			args := make([]string, len(m.params))
+			for i, p := range m.params {
+				fmt.Fprintf(&b, "%s, ", p.name)
+				args[i] = p.name
+				if _, ok := p.typ.(*ast.Ellipsis); ok {
+					args[i] += "..."
+				}
+			}
+			fmt.Fprintf(&b, "})\nfn := f.%sFunc\nf.mu.Unlock()\n", m.name)
+			fmt.Fprintf(&b, "if fn != nil {\nreturn fn(%s)\n}\n", strings.Join(args, ", "))
+			b.WriteString(zeroReturn(m.results))
+			b.WriteString("}\n\n")
+		}
+	}
+	return prepend("package jikantest", b.Bytes())
+}
+
+// prepend adds the generated-code header, the package clause and the
+// imports the body refers to.
+func prepend(pkg string, body []byte) []byte {
+	var b bytes.Buffer
+	b.WriteString(header + pkg + "\n\nimport (\n")
+	for _, imp := range []string{"context", "encoding/json", "iter", "net/url", "sync"} {
+		if bytes.Contains(body, []byte(path.Base(imp)+".")) {
+			fmt.Fprintf(&b, "%q\n", imp)
+		}
+	}
+	if pkg != "package jikan" && bytes.Contains(body, []byte("jikan.")) {
+		b.WriteString("\n\"github.com/Sethispr/jikanGo\"\n")
+	}
+	b.WriteString(")\n\n")
+	b.Write(body)
+	return b.Bytes()
+}
+
+func writeDoc(b *bytes.Buffer, doc string) {
+	for _, line := range strings.Split(strings.TrimSpace(doc), "\n") {
+		if line != "" {
+			fmt.Fprintf(b, "// %s\n", line)
+		}
+	}
+}
+
+func signature(m method, conv func(ast.Expr) ast.Expr) string {
+	ps := make([]string, len(m.params))
+	for i, p := range m.params {
+		ps[i] = p.name + " " + types.ExprString(conv(p.typ))
+	}
+	rs := make([]string, len(m.results))
+	for i, r := range m.results {
+		rs[i] = types.ExprString(conv(r))
+	}
+	sig := "(" + strings.Join(ps, ", ") + ")"
+	switch len(rs) {
+	case 0:
+	case 1:
+		sig += " " + rs[0]
+	default:
+		sig += " (" + strings.Join(rs, ", ") + ")"
+	}
+	return sig
+}
+
+func zeroReturn(results []ast.Expr) string {
+	if len(results) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	vals := make([]string, len(results))
+	for i, r := range results {
+		q := qualify(r)
+		if ix, ok := q.(*ast.IndexListExpr); ok && types.ExprString(ix.X) == "iter.Seq2" {
+			vals[i] = fmt.Sprintf("func(func(%s, %s) bool) {}", types.ExprString(ix.Indices[0]), types.ExprString(ix.Indices[1]))
+			continue
+		}
+		// An empty *BatchResult rather than nil, so callers can use Err
+		// and the maps without a nil check.
+		if star, ok := q.(*ast.StarExpr); ok && strings.HasPrefix(types.ExprString(star.X), "jikan.BatchResult[") {
+			t := types.ExprString(star.X)
+			vals[i] = fmt.Sprintf("&%s{Items: map[jikan.ID]*%s{}, Errors: map[jikan.ID]error{}}", t, strings.TrimSuffix(strings.TrimPrefix(t, "jikan.BatchResult["), "]"))
+			continue
+		}
+		vals[i] = fmt.Sprintf("r%d", i)
+		fmt.Fprintf(&b, "var r%d %s\n", i, types.ExprString(q))
+	}
+	fmt.Fprintf(&b, "return %s\n", strings.Join(vals, ", "))
+	return b.String()
+}
+
+func fieldType(e ast.Expr) string {
+	if el, ok := e.(*ast.Ellipsis); ok {
+		return "[]" + types.ExprString(el.Elt)
+	}
+	return types.ExprString(e)
+}
+
+var initialisms = map[string]string{"id": "ID", "ids": "IDs", "url": "URL"}
+
+func exportName(s string) string {
+	if v, ok := initialisms[s]; ok {
+		return v
+	}
+	for k, v := range initialisms {
+		if strings.HasPrefix(s, k) && len(s) > len(k) && s[len(k)] >= 'A' && s[len(k)] <= 'Z' {
+			return v + s[len(k):]
+		}
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func asIs(e ast.Expr) ast.Expr { return e }
+
+// qualify rewrites a type expression from package jikan for use outside
+// it by prefixing exported identifiers with "jikan.".
+func qualify(e ast.Expr) ast.Expr {
+	switch t := e.(type) {
+	case *ast.Ident:
+		if t.IsExported() {
+			return &ast.SelectorExpr{X: ast.NewIdent("jikan"), Sel: t}
+		}
+		return t
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(t.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: t.Len, Elt: qualify(t.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(t.Key), Value: qualify(t.Value)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: qualify(t.Elt)}
+	case *ast.IndexExpr:
+		return &ast.IndexExpr{X: qualify(t.X), Index: qualify(t.Index)}
+	case *ast.IndexListExpr:
+		ix := &ast.IndexListExpr{X: qualify(t.X)}
+		for _, i := range t.Indices {
+			ix.Indices = append(ix.Indices, qualify(i))
+		}
+		return ix
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: t.Dir, Value: qualify(t.Value)}
+	case *ast.StructType:
+		st := &ast.StructType{Fields: &ast.FieldList{}}
+		for _, f := range t.Fields.List {
+			st.Fields.List = append(st.Fields.List, &ast.Field{Names: f.Names, Type: qualify(f.Type), Tag: f.Tag})
+		}
+		return st
+	case *ast.FuncType:
+		ft := &ast.FuncType{Params: qualifyFields(t.Params), Results: qualifyFields(t.Results)}
+		return ft
+	}
+	return e
+}
+
+func qualifyFields(fl *ast.FieldList) *ast.FieldList {
+	if fl == nil {
+		return nil
+	}
+	out := &ast.FieldList{}
+	for _, f := range fl.List {
+		out.List = append(out.List, &ast.Field{Names: f.Names, Type: qualify(f.Type)})
+	}
+	return out
+}
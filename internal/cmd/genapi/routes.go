@@ -0,0 +1,314 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+)
+
+// route is one request a service method makes: a sample path for it and
+// the Go expression, valid in package jikantest, that constructs the
+// envelope the response is decoded into.
+type route struct {
+	method string
+	path   string
+	model  string
+}
+
+// samples are the path values used for parameters with these names, so
+// generated paths are real ones that can be recorded.
+var samples = map[string]string{
+	"year":     "2024",
+	"season":   "winter",
+	"username": "nekomata1037",
+}
+
+// routes walks each service method for its fetch, fetchPaged, fetchRaw,
+// paginate and Client.Do calls, following calls to unexported helpers on
+// the same service.
+func routes(services []service) ([]route, error) {
+	var out []route
+	seen := map[string]bool{}
+	for _, s := range services {
+		for _, m := range s.methods {
+			w := &routeWalk{svc: s, name: s.typ + "." + m.name}
+			w.walk(m.decl, nil)
+			if w.err != nil {
+				return nil, w.err
+			}
+			for _, r := range w.found {
+				if k := r.path + " " + r.model; !seen[k] {
+					seen[k] = true
+					out = append(out, r)
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+type routeWalk struct {
+	svc   service
+	name  string
+	found []route
+	err   error
+}
+
+// walk inspects fd with its parameters bound to the values in env.
+func (w *routeWalk) walk(fd *ast.FuncDecl, env map[string]string) {
+	recv := ""
+	if names := fd.Recv.List[0].Names; len(names) > 0 {
+		recv = names[0].Name
+	}
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || w.err != nil {
+			return w.err == nil
+		}
+		fn, targs := call.Fun, []ast.Expr(nil)
+		switch ix := fn.(type) {
+		case *ast.IndexExpr:
+			fn, targs = ix.X, []ast.Expr{ix.Index}
+		case *ast.IndexListExpr:
+			fn, targs = ix.X, ix.Indices
+		}
+		switch fn := fn.(type) {
+		case *ast.Ident:
+			if len(targs) != 1 || len(call.Args) < 3 {
+				return true
+			}
+			t := source(w.qualify(fd, targs[0]))
+			switch fn.Name {
+			case "fetch", "fetchRaw":
+				w.add(call.Args[2], env, "one["+t+"]")
+			case "fetchPaged":
+				w.add(call.Args[2], env, "page["+t+"]")
+			case "paginate":
+				w.add(call.Args[2], env, "page[[]"+t+"]")
+			}
+		case *ast.SelectorExpr:
+			// s.c.Do(ctx, method, path, query, &r)
+			if sel, ok := fn.X.(*ast.SelectorExpr); ok && fn.Sel.Name == "Do" && len(call.Args) == 5 {
+				if id, ok := sel.X.(*ast.Ident); ok && id.Name == recv {
+					w.addDo(fd, call, env)
+				}
+				return true
+			}
+			x, ok := fn.X.(*ast.Ident)
+			if !ok || x.Name != recv || fn.Sel.IsExported() {
+				return true
+			}
+			helper, ok := w.svc.helpers[fn.Sel.Name]
+			if !ok {
+				return true
+			}
+			inner := map[string]string{}
+			i := 0
+			for _, f := range helper.Type.Params.List {
+				for _, n := range f.Names {
+					if i < len(call.Args) {
+						if v, ok := w.eval(call.Args[i], env); ok {
+							inner[n.Name] = v
+						}
+					}
+					i++
+				}
+			}
+			w.walk(helper, inner)
+		}
+		return true
+	})
+}
+
+func (w *routeWalk) addDo(fd *ast.FuncDecl, call *ast.CallExpr, env map[string]string) {
+	u, ok := call.Args[4].(*ast.UnaryExpr)
+	if !ok || u.Op != token.AND {
+		return
+	}
+	id, ok := u.X.(*ast.Ident)
+	if !ok {
+		return
+	}
+	typ := localVar(fd, id.Name)
+	if typ == nil {
+		w.err = fmt.Errorf("%s: cannot find the type of %s", w.name, id.Name)
+		return
+	}
+	w.add(call.Args[2], env, "func() any { return new("+source(w.qualify(fd, typ))+") }")
+}
+
+func (w *routeWalk) add(path ast.Expr, env map[string]string, model string) {
+	p, ok := w.eval(path, env)
+	if !ok {
+		w.err = fmt.Errorf("%s: cannot work out the path %s", w.name, types.ExprString(path))
+		return
+	}
+	w.found = append(w.found, route{method: w.name, path: p, model: model})
+}
+
+// eval works out the value of a path expression, standing in sample
+// values for the parameters it refers to.
+func (w *routeWalk) eval(e ast.Expr, env map[string]string) (string, bool) {
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return "", false
+		}
+		s, err := strconv.Unquote(e.Value)
+		return s, err == nil
+	case *ast.Ident:
+		if v, ok := env[e.Name]; ok {
+			return v, true
+		}
+		return sample(e.Name, 's'), true
+	case *ast.BinaryExpr:
+		x, ok1 := w.eval(e.X, env)
+		y, ok2 := w.eval(e.Y, env)
+		return x + y, ok1 && ok2 && e.Op == token.ADD
+	case *ast.CallExpr:
+		switch types.ExprString(e.Fun) {
+		case "fmt.Sprintf":
+			return w.sprintf(e.Args, env)
+		case "userPath":
+			if len(e.Args) == 0 {
+				return "", false
+			}
+			p := "/users/" + w.arg(e.Args[0], env, 's')
+			for _, a := range e.Args[1:] {
+				v, ok := w.eval(a, env)
+				if !ok {
+					return "", false
+				}
+				p += "/" + v
+			}
+			return p, true
+		}
+	}
+	return "", false
+}
+
+func (w *routeWalk) sprintf(args []ast.Expr, env map[string]string) (string, bool) {
+	if len(args) == 0 {
+		return "", false
+	}
+	f, ok := w.eval(args[0], env)
+	if !ok {
+		return "", false
+	}
+	var b strings.Builder
+	args = args[1:]
+	for i := 0; i < len(f); i++ {
+		if f[i] != '%' || i+1 == len(f) {
+			b.WriteByte(f[i])
+			continue
+		}
+		i++
+		if f[i] == '%' {
+			b.WriteByte('%')
+			continue
+		}
+		if len(args) == 0 {
+			return "", false
+		}
+		b.WriteString(w.arg(args[0], env, f[i]))
+		args = args[1:]
+	}
+	return b.String(), true
+}
+
+// arg is the value of a Sprintf or userPath argument: a bound or literal
+// value if there is one, otherwise a sample for the parameter's name.
+func (w *routeWalk) arg(e ast.Expr, env map[string]string, verb byte) string {
+	if id, ok := e.(*ast.Ident); ok {
+		if v, ok := env[id.Name]; ok {
+			return v
+		}
+		return sample(id.Name, verb)
+	}
+	if v, ok := w.eval(e, env); ok {
+		return v
+	}
+	return sample("", verb)
+}
+
+func sample(name string, verb byte) string {
+	if v, ok := samples[name]; ok {
+		return v
+	}
+	if verb == 'd' {
+		return "1"
+	}
+	return name
+}
+
+// localVar returns the declared type of a variable in fd's body.
+func localVar(fd *ast.FuncDecl, name string) ast.Expr {
+	var typ ast.Expr
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		vs, ok := n.(*ast.ValueSpec)
+		if !ok || vs.Type == nil {
+			return typ == nil
+		}
+		for _, id := range vs.Names {
+			if id.Name == name {
+				typ = vs.Type
+			}
+		}
+		return typ == nil
+	})
+	return typ
+}
+
+// qualify is the package-level qualify that also inlines the types
+// declared inside fd, which are not visible outside it.
+func (w *routeWalk) qualify(fd *ast.FuncDecl, e ast.Expr) ast.Expr {
+	local := map[string]ast.Expr{}
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		if ts, ok := n.(*ast.TypeSpec); ok {
+			local[ts.Name.Name] = ts.Type
+		}
+		return true
+	})
+	var inline func(ast.Expr) ast.Expr
+	inline = func(e ast.Expr) ast.Expr {
+		switch t := e.(type) {
+		case *ast.Ident:
+			if l, ok := local[t.Name]; ok {
+				return inline(l)
+			}
+		case *ast.ArrayType:
+			return &ast.ArrayType{Len: t.Len, Elt: inline(t.Elt)}
+		case *ast.StarExpr:
+			return &ast.StarExpr{X: inline(t.X)}
+		}
+		return e
+	}
+	return qualify(inline(e))
+}
+
+// source prints a type expression, keeping the struct tags that
+// types.ExprString drops.
+func source(e ast.Expr) string {
+	var b bytes.Buffer
+	if err := format.Node(&b, token.NewFileSet(), e); err != nil {
+		return types.ExprString(e)
+	}
+	return b.String()
+}
+
+func genRoutes(rs []route) []byte {
+	var b bytes.Buffer
+	b.WriteString("// routes lists the requests the services make, one per endpoint and\n")
+	b.WriteString("// model, with a sample path and the envelope the response decodes into.\n")
+	b.WriteString("var routes = []route{\n")
+	for _, r := range rs {
+		fmt.Fprintf(&b, "{%q, %q, %s},\n", r.method, r.path, r.model)
+	}
+	b.WriteString("}\n")
+	return prepend("package jikantest", b.Bytes())
+}
@@ -0,0 +1,282 @@
+// Command genmodels generates Go model types from the vendored Jikan
+// OpenAPI document in internal/openapi. Only the schemas listed in
+// internal/openapi/gen.json are emitted; the rest of the models are still
+// written by hand. With -check it exits non-zero if the committed output
+// differs from what the spec produces.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// schema is the part of an OpenAPI schema object the generator reads.
+// Properties keeps the document order so fields come out as listed.
+type schema struct {
+	Ref         string     `json:"$ref"`
+	Type        string     `json:"type"`
+	Description string     `json:"description"`
+	Nullable    bool       `json:"nullable"`
+	Enum        []string   `json:"enum"`
+	Items       *schema    `json:"items"`
+	OneOf       []*schema  `json:"oneOf"`
+	Properties  properties `json:"properties"`
+}
+
+type property struct {
+	name string
+	*schema
+}
+
+type properties []property
+
+func (p *properties) UnmarshalJSON(b []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		s := new(schema)
+		if err := dec.Decode(s); err != nil {
+			return err
+		}
+		*p = append(*p, property{tok.(string), s})
+	}
+	return nil
+}
+
+type document struct {
+	Components struct {
+		Schemas map[string]*schema `json:"schemas"`
+	} `json:"components"`
+}
+
+// config maps spec names to Go names. Fields keys are "schema.property",
+// with "*" matching any schema; a more specific key wins. Extra lists
+// fields a model keeps that are not in its schema, such as ones from
+// older API versions that hand-written code now fills in.
+type config struct {
+	Schemas map[string]string       `json:"schemas"`
+	Refs    map[string]string       `json:"refs"`
+	Fields  map[string]string       `json:"fields"`
+	Enums   map[string]string       `json:"enums"`
+	Extra   map[string][]extraField `json:"extra"`
+}
+
+type extraField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Doc  string `json:"doc"`
+}
+
+func main() {
+	var (
+		specPath = flag.String("spec", "internal/openapi/jikan.json", "OpenAPI document")
+		confPath = flag.String("config", "internal/openapi/gen.json", "generator config")
+		out      = flag.String("out", "models_gen.go", "output file")
+		check    = flag.Bool("check", false, "compare with the existing output instead of writing it")
+	)
+	flag.Parse()
+
+	var doc document
+	if err := readJSON(*specPath, &doc); err != nil {
+		log.Fatal(err)
+	}
+	var conf config
+	if err := readJSON(*confPath, &conf); err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(&doc, &conf)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if !*check {
+		if err := os.WriteFile(*out, src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	old, err := os.ReadFile(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if line, ok := firstDiff(old, src); !ok {
+		fmt.Fprintf(os.Stderr, "%s is out of date (first difference at line %d); run go generate\n", *out, line)
+		os.Exit(1)
+	}
+}
+
+func readJSON(path string, v any) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+func firstDiff(a, b []byte) (int, bool) {
+	al, bl := bytes.Split(a, []byte("\n")), bytes.Split(b, []byte("\n"))
+	for i := range min(len(al), len(bl)) {
+		if !bytes.Equal(al[i], bl[i]) {
+			return i + 1, false
+		}
+	}
+	if len(al) != len(bl) {
+		return min(len(al), len(bl)) + 1, false
+	}
+	return 0, true
+}
+
+type generator struct {
+	conf  *config
+	buf   bytes.Buffer
+	enums bytes.Buffer
+}
+
+func generate(doc *document, conf *config) ([]byte, error) {
+	g := &generator{conf: conf}
+	g.buf.WriteString("// Code generated by genmodels from internal/openapi/jikan.json. DO NOT EDIT.\n\npackage jikan\n\n")
+
+	names := make([]string, 0, len(conf.Schemas))
+	for name := range conf.Schemas {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		s, ok := doc.Components.Schemas[name]
+		if !ok {
+			return nil, fmt.Errorf("schema %q is not in the spec", name)
+		}
+		if err := g.model(name, conf.Schemas[name], s); err != nil {
+			return nil, err
+		}
+	}
+	g.buf.Write(g.enums.Bytes())
+	return format.Source(g.buf.Bytes())
+}
+
+func (g *generator) model(specName, goName string, s *schema) error {
+	fmt.Fprintf(&g.buf, "// %s is generated from the %s schema.\n", goName, specName)
+	fmt.Fprintf(&g.buf, "type %s struct {\n", goName)
+	for _, p := range s.Properties {
+		typ, err := g.fieldType(specName, p)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", specName, p.name, err)
+		}
+		fmt.Fprintf(&g.buf, "%s %s `json:\"%s\"`\n", goIdent(p.name), typ, p.name)
+	}
+	for _, f := range g.conf.Extra[specName] {
+		if f.Doc != "" {
+			fmt.Fprintf(&g.buf, "\n// %s\n", f.Doc)
+		}
+		fmt.Fprintf(&g.buf, "%s %s `json:\"-\"`\n", f.Name, f.Type)
+	}
+	g.buf.WriteString("}\n\n")
+	return nil
+}
+
+func (g *generator) fieldType(specName string, p property) (string, error) {
+	if t, ok := g.conf.Fields[specName+"."+p.name]; ok {
+		return t, nil
+	}
+	if t, ok := g.conf.Enums[specName+"."+p.name]; ok {
+		g.enum(t, specName+"."+p.name, p.Enum)
+		return t, nil
+	}
+	if t, ok := g.conf.Fields["*."+p.name]; ok {
+		return t, nil
+	}
+	return g.goType(p.schema)
+}
+
+func (g *generator) goType(s *schema) (string, error) {
+	if s.Ref != "" {
+		name := s.Ref[strings.LastIndex(s.Ref, "/")+1:]
+		if t, ok := g.conf.Refs[name]; ok {
+			return t, nil
+		}
+		if t, ok := g.conf.Schemas[name]; ok {
+			return t, nil
+		}
+		return "", fmt.Errorf("no Go type for %s", s.Ref)
+	}
+	if len(s.OneOf) > 0 {
+		var t string
+		for _, o := range s.OneOf {
+			ot, err := g.goType(o)
+			if err != nil {
+				return "", err
+			}
+			if t != "" && ot != t {
+				return "", fmt.Errorf("oneOf maps to both %s and %s", t, ot)
+			}
+			t = ot
+		}
+		return t, nil
+	}
+	var t string
+	switch s.Type {
+	case "integer":
+		t = "int"
+	case "number":
+		t = "float64"
+	case "boolean":
+		t = "bool"
+	case "string":
+		return "string", nil
+	case "array":
+		if s.Items == nil {
+			return "", fmt.Errorf("array without items")
+		}
+		et, err := g.goType(s.Items)
+		return "[]" + et, err
+	default:
+		return "", fmt.Errorf("unsupported type %q", s.Type)
+	}
+	if s.Nullable {
+		return "Optional[" + t + "]", nil
+	}
+	return t, nil
+}
+
+func (g *generator) enum(name, from string, values []string) {
+	fmt.Fprintf(&g.enums, "// %s is generated from the %s enum.\ntype %s string\n\nconst (\n", name, from, name)
+	for _, v := range values {
+		fmt.Fprintf(&g.enums, "%s%s %s = %q\n", name, goIdent(v), name, v)
+	}
+	g.enums.WriteString(")\n\n")
+}
+
+var initialisms = map[string]string{"id": "ID", "url": "URL", "mal": "Mal"}
+
+// goIdent turns snake_case or free text into an exported Go identifier.
+func goIdent(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var b strings.Builder
+	for _, w := range words {
+		if v, ok := initialisms[strings.ToLower(w)]; ok {
+			b.WriteString(v)
+			continue
+		}
+		b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+	return b.String()
+}
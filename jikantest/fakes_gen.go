@@ -0,0 +1,3223 @@
+// Code generated by genapi. DO NOT EDIT.
+
+package jikantest
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"sync"
+
+	"github.com/Sethispr/jikanGo"
+)
+
+// FakeAPI is an in-memory jikan.API built from one fake per service.
+type FakeAPI struct {
+	Anime          *FakeAnime
+	Manga          *FakeManga
+	Character      *FakeCharacter
+	People         *FakePeople
+	User           *FakeUser
+	Season         *FakeSeason
+	Top            *FakeTop
+	Producer       *FakeProducer
+	Magazine       *FakeMagazine
+	Genre          *FakeGenre
+	Search         *FakeSearch
+	Review         *FakeReview
+	Recommendation *FakeRecommendation
+	Watch          *FakeWatch
+	Club           *FakeClub
+	Random         *FakeRandom
+}
+
+// NewFakeAPI returns a FakeAPI with every service fake allocated.
+func NewFakeAPI() *FakeAPI {
+	return &FakeAPI{
+		Anime:          new(FakeAnime),
+		Manga:          new(FakeManga),
+		Character:      new(FakeCharacter),
+		People:         new(FakePeople),
+		User:           new(FakeUser),
+		Season:         new(FakeSeason),
+		Top:            new(FakeTop),
+		Producer:       new(FakeProducer),
+		Magazine:       new(FakeMagazine),
+		Genre:          new(FakeGenre),
+		Search:         new(FakeSearch),
+		Review:         new(FakeReview),
+		Recommendation: new(FakeRecommendation),
+		Watch:          new(FakeWatch),
+		Club:           new(FakeClub),
+		Random:         new(FakeRandom),
+	}
+}
+
+func (f *FakeAPI) AnimeAPI() jikan.AnimeAPI                   { return f.Anime }
+func (f *FakeAPI) MangaAPI() jikan.MangaAPI                   { return f.Manga }
+func (f *FakeAPI) CharacterAPI() jikan.CharacterAPI           { return f.Character }
+func (f *FakeAPI) PeopleAPI() jikan.PeopleAPI                 { return f.People }
+func (f *FakeAPI) UserAPI() jikan.UserAPI                     { return f.User }
+func (f *FakeAPI) SeasonAPI() jikan.SeasonAPI                 { return f.Season }
+func (f *FakeAPI) TopAPI() jikan.TopAPI                       { return f.Top }
+func (f *FakeAPI) ProducerAPI() jikan.ProducerAPI             { return f.Producer }
+func (f *FakeAPI) MagazineAPI() jikan.MagazineAPI             { return f.Magazine }
+func (f *FakeAPI) GenreAPI() jikan.GenreAPI                   { return f.Genre }
+func (f *FakeAPI) SearchAPI() jikan.SearchAPI                 { return f.Search }
+func (f *FakeAPI) ReviewAPI() jikan.ReviewAPI                 { return f.Review }
+func (f *FakeAPI) RecommendationAPI() jikan.RecommendationAPI { return f.Recommendation }
+func (f *FakeAPI) WatchAPI() jikan.WatchAPI                   { return f.Watch }
+func (f *FakeAPI) ClubAPI() jikan.ClubAPI                     { return f.Club }
+func (f *FakeAPI) RandomAPI() jikan.RandomAPI                 { return f.Random }
+
+var _ jikan.API = (*FakeAPI)(nil)
+
+// FakeAnime is an in-memory jikan.AnimeAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakeAnime struct {
+	mu sync.Mutex
+
+	ByIDFunc              func(ctx context.Context, id jikan.ID) (*jikan.Anime, error)
+	ByIDCalls             []FakeAnimeByIDCall
+	ByIDRawFunc           func(ctx context.Context, id jikan.ID) (*jikan.Anime, json.RawMessage, error)
+	ByIDRawCalls          []FakeAnimeByIDRawCall
+	ByIDsFunc             func(ctx context.Context, ids []jikan.ID, opts jikan.BatchOptions) *jikan.BatchResult[jikan.Anime]
+	ByIDsCalls            []FakeAnimeByIDsCall
+	CharactersFunc        func(ctx context.Context, id jikan.ID) ([]jikan.AnimeCharacter, error)
+	CharactersCalls       []FakeAnimeCharactersCall
+	StaffFunc             func(ctx context.Context, id jikan.ID) ([]jikan.AnimeStaff, error)
+	StaffCalls            []FakeAnimeStaffCall
+	EpisodesFunc          func(ctx context.Context, id jikan.ID, page int) ([]jikan.Episode, *jikan.Pagination, error)
+	EpisodesCalls         []FakeAnimeEpisodesCall
+	EpisodesAllFunc       func(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Episode, error]
+	EpisodesAllCalls      []FakeAnimeEpisodesAllCall
+	EpisodeByIDFunc       func(ctx context.Context, animeID jikan.ID, episode int) (*jikan.EpisodeDetail, error)
+	EpisodeByIDCalls      []FakeAnimeEpisodeByIDCall
+	NewsFunc              func(ctx context.Context, id jikan.ID, page int) ([]jikan.AnimeNews, *jikan.Pagination, error)
+	NewsCalls             []FakeAnimeNewsCall
+	NewsAllFunc           func(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.AnimeNews, error]
+	NewsAllCalls          []FakeAnimeNewsAllCall
+	ForumFunc             func(ctx context.Context, id jikan.ID, filter jikan.ForumFilter) ([]jikan.AnimeTopic, error)
+	ForumCalls            []FakeAnimeForumCall
+	VideosFunc            func(ctx context.Context, id jikan.ID) (*jikan.AnimeVideos, error)
+	VideosCalls           []FakeAnimeVideosCall
+	VideoEpisodesFunc     func(ctx context.Context, id jikan.ID, page int) ([]jikan.VideoEpisode, *jikan.Pagination, error)
+	VideoEpisodesCalls    []FakeAnimeVideoEpisodesCall
+	VideoEpisodesAllFunc  func(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.VideoEpisode, error]
+	VideoEpisodesAllCalls []FakeAnimeVideoEpisodesAllCall
+	PicturesFunc          func(ctx context.Context, id jikan.ID) ([]jikan.ImageSet, error)
+	PicturesCalls         []FakeAnimePicturesCall
+	StatisticsFunc        func(ctx context.Context, id jikan.ID) (*jikan.AnimeStats, error)
+	StatisticsCalls       []FakeAnimeStatisticsCall
+	MoreInfoFunc          func(ctx context.Context, id jikan.ID) (string, error)
+	MoreInfoCalls         []FakeAnimeMoreInfoCall
+	RecommendationsFunc   func(ctx context.Context, id jikan.ID) ([]jikan.AnimeRecommendation, error)
+	RecommendationsCalls  []FakeAnimeRecommendationsCall
+	UserUpdatesFunc       func(ctx context.Context, id jikan.ID, page int) ([]jikan.AnimeUserUpdate, *jikan.Pagination, error)
+	UserUpdatesCalls      []FakeAnimeUserUpdatesCall
+	UserUpdatesAllFunc    func(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.AnimeUserUpdate, error]
+	UserUpdatesAllCalls   []FakeAnimeUserUpdatesAllCall
+	ReviewsFunc           func(ctx context.Context, id jikan.ID, page int) ([]jikan.AnimeReview, *jikan.Pagination, error)
+	ReviewsCalls          []FakeAnimeReviewsCall
+	ReviewsAllFunc        func(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.AnimeReview, error]
+	ReviewsAllCalls       []FakeAnimeReviewsAllCall
+	RelationsFunc         func(ctx context.Context, id jikan.ID) ([]jikan.Relation, error)
+	RelationsCalls        []FakeAnimeRelationsCall
+	ThemesFunc            func(ctx context.Context, id jikan.ID) (*jikan.AnimeThemes, error)
+	ThemesCalls           []FakeAnimeThemesCall
+	ExternalFunc          func(ctx context.Context, id jikan.ID) ([]jikan.ExternalLink, error)
+	ExternalCalls         []FakeAnimeExternalCall
+	StreamingFunc         func(ctx context.Context, id jikan.ID) ([]jikan.ExternalLink, error)
+	StreamingCalls        []FakeAnimeStreamingCall
+}
+
+var _ jikan.AnimeAPI = (*FakeAnime)(nil)
+
+// FakeAnimeByIDCall holds the arguments of one FakeAnime.ByID call.
+type FakeAnimeByIDCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeAnime) ByID(ctx context.Context, id jikan.ID) (*jikan.Anime, error) {
+	f.mu.Lock()
+	f.ByIDCalls = append(f.ByIDCalls, FakeAnimeByIDCall{ctx, id})
+	fn := f.ByIDFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.Anime
+	var r1 error
+	return r0, r1
+}
+
+// FakeAnimeByIDRawCall holds the arguments of one FakeAnime.ByIDRaw call.
+type FakeAnimeByIDRawCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeAnime) ByIDRaw(ctx context.Context, id jikan.ID) (*jikan.Anime, json.RawMessage, error) {
+	f.mu.Lock()
+	f.ByIDRawCalls = append(f.ByIDRawCalls, FakeAnimeByIDRawCall{ctx, id})
+	fn := f.ByIDRawFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.Anime
+	var r1 json.RawMessage
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeAnimeByIDsCall holds the arguments of one FakeAnime.ByIDs call.
+type FakeAnimeByIDsCall struct {
+	Ctx  context.Context
+	IDs  []jikan.ID
+	Opts jikan.BatchOptions
+}
+
+func (f *FakeAnime) ByIDs(ctx context.Context, ids []jikan.ID, opts jikan.BatchOptions) *jikan.BatchResult[jikan.Anime] {
+	f.mu.Lock()
+	f.ByIDsCalls = append(f.ByIDsCalls, FakeAnimeByIDsCall{ctx, ids, opts})
+	fn := f.ByIDsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, ids, opts)
+	}
+	return &jikan.BatchResult[jikan.Anime]{Items: map[jikan.ID]*jikan.Anime{}, Errors: map[jikan.ID]error{}}
+}
+
+// FakeAnimeCharactersCall holds the arguments of one FakeAnime.Characters call.
+type FakeAnimeCharactersCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeAnime) Characters(ctx context.Context, id jikan.ID) ([]jikan.AnimeCharacter, error) {
+	f.mu.Lock()
+	f.CharactersCalls = append(f.CharactersCalls, FakeAnimeCharactersCall{ctx, id})
+	fn := f.CharactersFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.AnimeCharacter
+	var r1 error
+	return r0, r1
+}
+
+// FakeAnimeStaffCall holds the arguments of one FakeAnime.Staff call.
+type FakeAnimeStaffCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeAnime) Staff(ctx context.Context, id jikan.ID) ([]jikan.AnimeStaff, error) {
+	f.mu.Lock()
+	f.StaffCalls = append(f.StaffCalls, FakeAnimeStaffCall{ctx, id})
+	fn := f.StaffFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.AnimeStaff
+	var r1 error
+	return r0, r1
+}
+
+// FakeAnimeEpisodesCall holds the arguments of one FakeAnime.Episodes call.
+type FakeAnimeEpisodesCall struct {
+	Ctx  context.Context
+	ID   jikan.ID
+	Page int
+}
+
+func (f *FakeAnime) Episodes(ctx context.Context, id jikan.ID, page int) ([]jikan.Episode, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.EpisodesCalls = append(f.EpisodesCalls, FakeAnimeEpisodesCall{ctx, id, page})
+	fn := f.EpisodesFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, page)
+	}
+	var r0 []jikan.Episode
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeAnimeEpisodesAllCall holds the arguments of one FakeAnime.EpisodesAll call.
+type FakeAnimeEpisodesAllCall struct {
+	Ctx      context.Context
+	ID       jikan.ID
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeAnime) EpisodesAll(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Episode, error] {
+	f.mu.Lock()
+	f.EpisodesAllCalls = append(f.EpisodesAllCalls, FakeAnimeEpisodesAllCall{ctx, id, pageOpts})
+	fn := f.EpisodesAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, pageOpts...)
+	}
+	return func(func(jikan.Episode, error) bool) {}
+}
+
+// FakeAnimeEpisodeByIDCall holds the arguments of one FakeAnime.EpisodeByID call.
+type FakeAnimeEpisodeByIDCall struct {
+	Ctx     context.Context
+	AnimeID jikan.ID
+	Episode int
+}
+
+func (f *FakeAnime) EpisodeByID(ctx context.Context, animeID jikan.ID, episode int) (*jikan.EpisodeDetail, error) {
+	f.mu.Lock()
+	f.EpisodeByIDCalls = append(f.EpisodeByIDCalls, FakeAnimeEpisodeByIDCall{ctx, animeID, episode})
+	fn := f.EpisodeByIDFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, animeID, episode)
+	}
+	var r0 *jikan.EpisodeDetail
+	var r1 error
+	return r0, r1
+}
+
+// FakeAnimeNewsCall holds the arguments of one FakeAnime.News call.
+type FakeAnimeNewsCall struct {
+	Ctx  context.Context
+	ID   jikan.ID
+	Page int
+}
+
+func (f *FakeAnime) News(ctx context.Context, id jikan.ID, page int) ([]jikan.AnimeNews, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.NewsCalls = append(f.NewsCalls, FakeAnimeNewsCall{ctx, id, page})
+	fn := f.NewsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, page)
+	}
+	var r0 []jikan.AnimeNews
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeAnimeNewsAllCall holds the arguments of one FakeAnime.NewsAll call.
+type FakeAnimeNewsAllCall struct {
+	Ctx      context.Context
+	ID       jikan.ID
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeAnime) NewsAll(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.AnimeNews, error] {
+	f.mu.Lock()
+	f.NewsAllCalls = append(f.NewsAllCalls, FakeAnimeNewsAllCall{ctx, id, pageOpts})
+	fn := f.NewsAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, pageOpts...)
+	}
+	return func(func(jikan.AnimeNews, error) bool) {}
+}
+
+// FakeAnimeForumCall holds the arguments of one FakeAnime.Forum call.
+type FakeAnimeForumCall struct {
+	Ctx    context.Context
+	ID     jikan.ID
+	Filter jikan.ForumFilter
+}
+
+func (f *FakeAnime) Forum(ctx context.Context, id jikan.ID, filter jikan.ForumFilter) ([]jikan.AnimeTopic, error) {
+	f.mu.Lock()
+	f.ForumCalls = append(f.ForumCalls, FakeAnimeForumCall{ctx, id, filter})
+	fn := f.ForumFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, filter)
+	}
+	var r0 []jikan.AnimeTopic
+	var r1 error
+	return r0, r1
+}
+
+// FakeAnimeVideosCall holds the arguments of one FakeAnime.Videos call.
+type FakeAnimeVideosCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeAnime) Videos(ctx context.Context, id jikan.ID) (*jikan.AnimeVideos, error) {
+	f.mu.Lock()
+	f.VideosCalls = append(f.VideosCalls, FakeAnimeVideosCall{ctx, id})
+	fn := f.VideosFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.AnimeVideos
+	var r1 error
+	return r0, r1
+}
+
+// FakeAnimeVideoEpisodesCall holds the arguments of one FakeAnime.VideoEpisodes call.
+type FakeAnimeVideoEpisodesCall struct {
+	Ctx  context.Context
+	ID   jikan.ID
+	Page int
+}
+
+func (f *FakeAnime) VideoEpisodes(ctx context.Context, id jikan.ID, page int) ([]jikan.VideoEpisode, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.VideoEpisodesCalls = append(f.VideoEpisodesCalls, FakeAnimeVideoEpisodesCall{ctx, id, page})
+	fn := f.VideoEpisodesFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, page)
+	}
+	var r0 []jikan.VideoEpisode
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeAnimeVideoEpisodesAllCall holds the arguments of one FakeAnime.VideoEpisodesAll call.
+type FakeAnimeVideoEpisodesAllCall struct {
+	Ctx      context.Context
+	ID       jikan.ID
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeAnime) VideoEpisodesAll(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.VideoEpisode, error] {
+	f.mu.Lock()
+	f.VideoEpisodesAllCalls = append(f.VideoEpisodesAllCalls, FakeAnimeVideoEpisodesAllCall{ctx, id, pageOpts})
+	fn := f.VideoEpisodesAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, pageOpts...)
+	}
+	return func(func(jikan.VideoEpisode, error) bool) {}
+}
+
+// FakeAnimePicturesCall holds the arguments of one FakeAnime.Pictures call.
+type FakeAnimePicturesCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeAnime) Pictures(ctx context.Context, id jikan.ID) ([]jikan.ImageSet, error) {
+	f.mu.Lock()
+	f.PicturesCalls = append(f.PicturesCalls, FakeAnimePicturesCall{ctx, id})
+	fn := f.PicturesFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.ImageSet
+	var r1 error
+	return r0, r1
+}
+
+// FakeAnimeStatisticsCall holds the arguments of one FakeAnime.Statistics call.
+type FakeAnimeStatisticsCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeAnime) Statistics(ctx context.Context, id jikan.ID) (*jikan.AnimeStats, error) {
+	f.mu.Lock()
+	f.StatisticsCalls = append(f.StatisticsCalls, FakeAnimeStatisticsCall{ctx, id})
+	fn := f.StatisticsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.AnimeStats
+	var r1 error
+	return r0, r1
+}
+
+// FakeAnimeMoreInfoCall holds the arguments of one FakeAnime.MoreInfo call.
+type FakeAnimeMoreInfoCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeAnime) MoreInfo(ctx context.Context, id jikan.ID) (string, error) {
+	f.mu.Lock()
+	f.MoreInfoCalls = append(f.MoreInfoCalls, FakeAnimeMoreInfoCall{ctx, id})
+	fn := f.MoreInfoFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 string
+	var r1 error
+	return r0, r1
+}
+
+// FakeAnimeRecommendationsCall holds the arguments of one FakeAnime.Recommendations call.
+type FakeAnimeRecommendationsCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeAnime) Recommendations(ctx context.Context, id jikan.ID) ([]jikan.AnimeRecommendation, error) {
+	f.mu.Lock()
+	f.RecommendationsCalls = append(f.RecommendationsCalls, FakeAnimeRecommendationsCall{ctx, id})
+	fn := f.RecommendationsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.AnimeRecommendation
+	var r1 error
+	return r0, r1
+}
+
+// FakeAnimeUserUpdatesCall holds the arguments of one FakeAnime.UserUpdates call.
+type FakeAnimeUserUpdatesCall struct {
+	Ctx  context.Context
+	ID   jikan.ID
+	Page int
+}
+
+func (f *FakeAnime) UserUpdates(ctx context.Context, id jikan.ID, page int) ([]jikan.AnimeUserUpdate, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.UserUpdatesCalls = append(f.UserUpdatesCalls, FakeAnimeUserUpdatesCall{ctx, id, page})
+	fn := f.UserUpdatesFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, page)
+	}
+	var r0 []jikan.AnimeUserUpdate
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeAnimeUserUpdatesAllCall holds the arguments of one FakeAnime.UserUpdatesAll call.
+type FakeAnimeUserUpdatesAllCall struct {
+	Ctx      context.Context
+	ID       jikan.ID
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeAnime) UserUpdatesAll(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.AnimeUserUpdate, error] {
+	f.mu.Lock()
+	f.UserUpdatesAllCalls = append(f.UserUpdatesAllCalls, FakeAnimeUserUpdatesAllCall{ctx, id, pageOpts})
+	fn := f.UserUpdatesAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, pageOpts...)
+	}
+	return func(func(jikan.AnimeUserUpdate, error) bool) {}
+}
+
+// FakeAnimeReviewsCall holds the arguments of one FakeAnime.Reviews call.
+type FakeAnimeReviewsCall struct {
+	Ctx  context.Context
+	ID   jikan.ID
+	Page int
+}
+
+func (f *FakeAnime) Reviews(ctx context.Context, id jikan.ID, page int) ([]jikan.AnimeReview, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.ReviewsCalls = append(f.ReviewsCalls, FakeAnimeReviewsCall{ctx, id, page})
+	fn := f.ReviewsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, page)
+	}
+	var r0 []jikan.AnimeReview
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeAnimeReviewsAllCall holds the arguments of one FakeAnime.ReviewsAll call.
+type FakeAnimeReviewsAllCall struct {
+	Ctx      context.Context
+	ID       jikan.ID
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeAnime) ReviewsAll(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.AnimeReview, error] {
+	f.mu.Lock()
+	f.ReviewsAllCalls = append(f.ReviewsAllCalls, FakeAnimeReviewsAllCall{ctx, id, pageOpts})
+	fn := f.ReviewsAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, pageOpts...)
+	}
+	return func(func(jikan.AnimeReview, error) bool) {}
+}
+
+// FakeAnimeRelationsCall holds the arguments of one FakeAnime.Relations call.
+type FakeAnimeRelationsCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeAnime) Relations(ctx context.Context, id jikan.ID) ([]jikan.Relation, error) {
+	f.mu.Lock()
+	f.RelationsCalls = append(f.RelationsCalls, FakeAnimeRelationsCall{ctx, id})
+	fn := f.RelationsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.Relation
+	var r1 error
+	return r0, r1
+}
+
+// FakeAnimeThemesCall holds the arguments of one FakeAnime.Themes call.
+type FakeAnimeThemesCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeAnime) Themes(ctx context.Context, id jikan.ID) (*jikan.AnimeThemes, error) {
+	f.mu.Lock()
+	f.ThemesCalls = append(f.ThemesCalls, FakeAnimeThemesCall{ctx, id})
+	fn := f.ThemesFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.AnimeThemes
+	var r1 error
+	return r0, r1
+}
+
+// FakeAnimeExternalCall holds the arguments of one FakeAnime.External call.
+type FakeAnimeExternalCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeAnime) External(ctx context.Context, id jikan.ID) ([]jikan.ExternalLink, error) {
+	f.mu.Lock()
+	f.ExternalCalls = append(f.ExternalCalls, FakeAnimeExternalCall{ctx, id})
+	fn := f.ExternalFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.ExternalLink
+	var r1 error
+	return r0, r1
+}
+
+// FakeAnimeStreamingCall holds the arguments of one FakeAnime.Streaming call.
+type FakeAnimeStreamingCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeAnime) Streaming(ctx context.Context, id jikan.ID) ([]jikan.ExternalLink, error) {
+	f.mu.Lock()
+	f.StreamingCalls = append(f.StreamingCalls, FakeAnimeStreamingCall{ctx, id})
+	fn := f.StreamingFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.ExternalLink
+	var r1 error
+	return r0, r1
+}
+
+// FakeManga is an in-memory jikan.MangaAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakeManga struct {
+	mu sync.Mutex
+
+	ByIDFunc             func(ctx context.Context, id jikan.ID) (*jikan.Manga, error)
+	ByIDCalls            []FakeMangaByIDCall
+	ByIDRawFunc          func(ctx context.Context, id jikan.ID) (*jikan.Manga, json.RawMessage, error)
+	ByIDRawCalls         []FakeMangaByIDRawCall
+	ByIDsFunc            func(ctx context.Context, ids []jikan.ID, opts jikan.BatchOptions) *jikan.BatchResult[jikan.Manga]
+	ByIDsCalls           []FakeMangaByIDsCall
+	FullFunc             func(ctx context.Context, id jikan.ID) (*jikan.MangaFull, error)
+	FullCalls            []FakeMangaFullCall
+	CharactersFunc       func(ctx context.Context, id jikan.ID) ([]jikan.MangaCharacter, error)
+	CharactersCalls      []FakeMangaCharactersCall
+	NewsFunc             func(ctx context.Context, id jikan.ID, page int) ([]jikan.MangaNews, *jikan.Pagination, error)
+	NewsCalls            []FakeMangaNewsCall
+	NewsAllFunc          func(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.MangaNews, error]
+	NewsAllCalls         []FakeMangaNewsAllCall
+	ForumFunc            func(ctx context.Context, id jikan.ID, filter string) ([]jikan.MangaTopic, error)
+	ForumCalls           []FakeMangaForumCall
+	PicturesFunc         func(ctx context.Context, id jikan.ID) ([]jikan.ImageSet, error)
+	PicturesCalls        []FakeMangaPicturesCall
+	StatisticsFunc       func(ctx context.Context, id jikan.ID) (*jikan.MangaStats, error)
+	StatisticsCalls      []FakeMangaStatisticsCall
+	MoreInfoFunc         func(ctx context.Context, id jikan.ID) (string, error)
+	MoreInfoCalls        []FakeMangaMoreInfoCall
+	RecommendationsFunc  func(ctx context.Context, id jikan.ID) ([]jikan.MangaRecommendation, error)
+	RecommendationsCalls []FakeMangaRecommendationsCall
+	UserUpdatesFunc      func(ctx context.Context, id jikan.ID, page int) ([]jikan.MangaUserUpdate, *jikan.Pagination, error)
+	UserUpdatesCalls     []FakeMangaUserUpdatesCall
+	UserUpdatesAllFunc   func(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.MangaUserUpdate, error]
+	UserUpdatesAllCalls  []FakeMangaUserUpdatesAllCall
+	ReviewsFunc          func(ctx context.Context, id jikan.ID, page int, preliminary bool, spoiler bool) ([]jikan.MangaReview, *jikan.Pagination, error)
+	ReviewsCalls         []FakeMangaReviewsCall
+	ReviewsAllFunc       func(ctx context.Context, id jikan.ID, preliminary bool, spoiler bool, pageOpts ...jikan.PageOption) iter.Seq2[jikan.MangaReview, error]
+	ReviewsAllCalls      []FakeMangaReviewsAllCall
+	RelationsFunc        func(ctx context.Context, id jikan.ID) ([]jikan.MangaRelation, error)
+	RelationsCalls       []FakeMangaRelationsCall
+	ExternalFunc         func(ctx context.Context, id jikan.ID) ([]jikan.ExternalLink, error)
+	ExternalCalls        []FakeMangaExternalCall
+	SearchFunc           func(ctx context.Context, opts jikan.MangaSearchOptions) ([]*jikan.Manga, *jikan.Pagination, error)
+	SearchCalls          []FakeMangaSearchCall
+	SearchAllFunc        func(ctx context.Context, opts jikan.MangaSearchOptions, pageOpts ...jikan.PageOption) iter.Seq2[*jikan.Manga, error]
+	SearchAllCalls       []FakeMangaSearchAllCall
+}
+
+var _ jikan.MangaAPI = (*FakeManga)(nil)
+
+// FakeMangaByIDCall holds the arguments of one FakeManga.ByID call.
+type FakeMangaByIDCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeManga) ByID(ctx context.Context, id jikan.ID) (*jikan.Manga, error) {
+	f.mu.Lock()
+	f.ByIDCalls = append(f.ByIDCalls, FakeMangaByIDCall{ctx, id})
+	fn := f.ByIDFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.Manga
+	var r1 error
+	return r0, r1
+}
+
+// FakeMangaByIDRawCall holds the arguments of one FakeManga.ByIDRaw call.
+type FakeMangaByIDRawCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeManga) ByIDRaw(ctx context.Context, id jikan.ID) (*jikan.Manga, json.RawMessage, error) {
+	f.mu.Lock()
+	f.ByIDRawCalls = append(f.ByIDRawCalls, FakeMangaByIDRawCall{ctx, id})
+	fn := f.ByIDRawFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.Manga
+	var r1 json.RawMessage
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeMangaByIDsCall holds the arguments of one FakeManga.ByIDs call.
+type FakeMangaByIDsCall struct {
+	Ctx  context.Context
+	IDs  []jikan.ID
+	Opts jikan.BatchOptions
+}
+
+func (f *FakeManga) ByIDs(ctx context.Context, ids []jikan.ID, opts jikan.BatchOptions) *jikan.BatchResult[jikan.Manga] {
+	f.mu.Lock()
+	f.ByIDsCalls = append(f.ByIDsCalls, FakeMangaByIDsCall{ctx, ids, opts})
+	fn := f.ByIDsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, ids, opts)
+	}
+	return &jikan.BatchResult[jikan.Manga]{Items: map[jikan.ID]*jikan.Manga{}, Errors: map[jikan.ID]error{}}
+}
+
+// FakeMangaFullCall holds the arguments of one FakeManga.Full call.
+type FakeMangaFullCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeManga) Full(ctx context.Context, id jikan.ID) (*jikan.MangaFull, error) {
+	f.mu.Lock()
+	f.FullCalls = append(f.FullCalls, FakeMangaFullCall{ctx, id})
+	fn := f.FullFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.MangaFull
+	var r1 error
+	return r0, r1
+}
+
+// FakeMangaCharactersCall holds the arguments of one FakeManga.Characters call.
+type FakeMangaCharactersCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeManga) Characters(ctx context.Context, id jikan.ID) ([]jikan.MangaCharacter, error) {
+	f.mu.Lock()
+	f.CharactersCalls = append(f.CharactersCalls, FakeMangaCharactersCall{ctx, id})
+	fn := f.CharactersFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.MangaCharacter
+	var r1 error
+	return r0, r1
+}
+
+// FakeMangaNewsCall holds the arguments of one FakeManga.News call.
+type FakeMangaNewsCall struct {
+	Ctx  context.Context
+	ID   jikan.ID
+	Page int
+}
+
+func (f *FakeManga) News(ctx context.Context, id jikan.ID, page int) ([]jikan.MangaNews, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.NewsCalls = append(f.NewsCalls, FakeMangaNewsCall{ctx, id, page})
+	fn := f.NewsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, page)
+	}
+	var r0 []jikan.MangaNews
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeMangaNewsAllCall holds the arguments of one FakeManga.NewsAll call.
+type FakeMangaNewsAllCall struct {
+	Ctx      context.Context
+	ID       jikan.ID
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeManga) NewsAll(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.MangaNews, error] {
+	f.mu.Lock()
+	f.NewsAllCalls = append(f.NewsAllCalls, FakeMangaNewsAllCall{ctx, id, pageOpts})
+	fn := f.NewsAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, pageOpts...)
+	}
+	return func(func(jikan.MangaNews, error) bool) {}
+}
+
+// FakeMangaForumCall holds the arguments of one FakeManga.Forum call.
+type FakeMangaForumCall struct {
+	Ctx    context.Context
+	ID     jikan.ID
+	Filter string
+}
+
+func (f *FakeManga) Forum(ctx context.Context, id jikan.ID, filter string) ([]jikan.MangaTopic, error) {
+	f.mu.Lock()
+	f.ForumCalls = append(f.ForumCalls, FakeMangaForumCall{ctx, id, filter})
+	fn := f.ForumFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, filter)
+	}
+	var r0 []jikan.MangaTopic
+	var r1 error
+	return r0, r1
+}
+
+// FakeMangaPicturesCall holds the arguments of one FakeManga.Pictures call.
+type FakeMangaPicturesCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeManga) Pictures(ctx context.Context, id jikan.ID) ([]jikan.ImageSet, error) {
+	f.mu.Lock()
+	f.PicturesCalls = append(f.PicturesCalls, FakeMangaPicturesCall{ctx, id})
+	fn := f.PicturesFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.ImageSet
+	var r1 error
+	return r0, r1
+}
+
+// FakeMangaStatisticsCall holds the arguments of one FakeManga.Statistics call.
+type FakeMangaStatisticsCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeManga) Statistics(ctx context.Context, id jikan.ID) (*jikan.MangaStats, error) {
+	f.mu.Lock()
+	f.StatisticsCalls = append(f.StatisticsCalls, FakeMangaStatisticsCall{ctx, id})
+	fn := f.StatisticsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.MangaStats
+	var r1 error
+	return r0, r1
+}
+
+// FakeMangaMoreInfoCall holds the arguments of one FakeManga.MoreInfo call.
+type FakeMangaMoreInfoCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeManga) MoreInfo(ctx context.Context, id jikan.ID) (string, error) {
+	f.mu.Lock()
+	f.MoreInfoCalls = append(f.MoreInfoCalls, FakeMangaMoreInfoCall{ctx, id})
+	fn := f.MoreInfoFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 string
+	var r1 error
+	return r0, r1
+}
+
+// FakeMangaRecommendationsCall holds the arguments of one FakeManga.Recommendations call.
+type FakeMangaRecommendationsCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeManga) Recommendations(ctx context.Context, id jikan.ID) ([]jikan.MangaRecommendation, error) {
+	f.mu.Lock()
+	f.RecommendationsCalls = append(f.RecommendationsCalls, FakeMangaRecommendationsCall{ctx, id})
+	fn := f.RecommendationsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.MangaRecommendation
+	var r1 error
+	return r0, r1
+}
+
+// FakeMangaUserUpdatesCall holds the arguments of one FakeManga.UserUpdates call.
+type FakeMangaUserUpdatesCall struct {
+	Ctx  context.Context
+	ID   jikan.ID
+	Page int
+}
+
+func (f *FakeManga) UserUpdates(ctx context.Context, id jikan.ID, page int) ([]jikan.MangaUserUpdate, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.UserUpdatesCalls = append(f.UserUpdatesCalls, FakeMangaUserUpdatesCall{ctx, id, page})
+	fn := f.UserUpdatesFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, page)
+	}
+	var r0 []jikan.MangaUserUpdate
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeMangaUserUpdatesAllCall holds the arguments of one FakeManga.UserUpdatesAll call.
+type FakeMangaUserUpdatesAllCall struct {
+	Ctx      context.Context
+	ID       jikan.ID
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeManga) UserUpdatesAll(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.MangaUserUpdate, error] {
+	f.mu.Lock()
+	f.UserUpdatesAllCalls = append(f.UserUpdatesAllCalls, FakeMangaUserUpdatesAllCall{ctx, id, pageOpts})
+	fn := f.UserUpdatesAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, pageOpts...)
+	}
+	return func(func(jikan.MangaUserUpdate, error) bool) {}
+}
+
+// FakeMangaReviewsCall holds the arguments of one FakeManga.Reviews call.
+type FakeMangaReviewsCall struct {
+	Ctx         context.Context
+	ID          jikan.ID
+	Page        int
+	Preliminary bool
+	Spoiler     bool
+}
+
+func (f *FakeManga) Reviews(ctx context.Context, id jikan.ID, page int, preliminary bool, spoiler bool) ([]jikan.MangaReview, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.ReviewsCalls = append(f.ReviewsCalls, FakeMangaReviewsCall{ctx, id, page, preliminary, spoiler})
+	fn := f.ReviewsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, page, preliminary, spoiler)
+	}
+	var r0 []jikan.MangaReview
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeMangaReviewsAllCall holds the arguments of one FakeManga.ReviewsAll call.
+type FakeMangaReviewsAllCall struct {
+	Ctx         context.Context
+	ID          jikan.ID
+	Preliminary bool
+	Spoiler     bool
+	PageOpts    []jikan.PageOption
+}
+
+func (f *FakeManga) ReviewsAll(ctx context.Context, id jikan.ID, preliminary bool, spoiler bool, pageOpts ...jikan.PageOption) iter.Seq2[jikan.MangaReview, error] {
+	f.mu.Lock()
+	f.ReviewsAllCalls = append(f.ReviewsAllCalls, FakeMangaReviewsAllCall{ctx, id, preliminary, spoiler, pageOpts})
+	fn := f.ReviewsAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, preliminary, spoiler, pageOpts...)
+	}
+	return func(func(jikan.MangaReview, error) bool) {}
+}
+
+// FakeMangaRelationsCall holds the arguments of one FakeManga.Relations call.
+type FakeMangaRelationsCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeManga) Relations(ctx context.Context, id jikan.ID) ([]jikan.MangaRelation, error) {
+	f.mu.Lock()
+	f.RelationsCalls = append(f.RelationsCalls, FakeMangaRelationsCall{ctx, id})
+	fn := f.RelationsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.MangaRelation
+	var r1 error
+	return r0, r1
+}
+
+// FakeMangaExternalCall holds the arguments of one FakeManga.External call.
+type FakeMangaExternalCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeManga) External(ctx context.Context, id jikan.ID) ([]jikan.ExternalLink, error) {
+	f.mu.Lock()
+	f.ExternalCalls = append(f.ExternalCalls, FakeMangaExternalCall{ctx, id})
+	fn := f.ExternalFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.ExternalLink
+	var r1 error
+	return r0, r1
+}
+
+// FakeMangaSearchCall holds the arguments of one FakeManga.Search call.
+type FakeMangaSearchCall struct {
+	Ctx  context.Context
+	Opts jikan.MangaSearchOptions
+}
+
+func (f *FakeManga) Search(ctx context.Context, opts jikan.MangaSearchOptions) ([]*jikan.Manga, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.SearchCalls = append(f.SearchCalls, FakeMangaSearchCall{ctx, opts})
+	fn := f.SearchFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts)
+	}
+	var r0 []*jikan.Manga
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeMangaSearchAllCall holds the arguments of one FakeManga.SearchAll call.
+type FakeMangaSearchAllCall struct {
+	Ctx      context.Context
+	Opts     jikan.MangaSearchOptions
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeManga) SearchAll(ctx context.Context, opts jikan.MangaSearchOptions, pageOpts ...jikan.PageOption) iter.Seq2[*jikan.Manga, error] {
+	f.mu.Lock()
+	f.SearchAllCalls = append(f.SearchAllCalls, FakeMangaSearchAllCall{ctx, opts, pageOpts})
+	fn := f.SearchAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts, pageOpts...)
+	}
+	return func(func(*jikan.Manga, error) bool) {}
+}
+
+// FakeCharacter is an in-memory jikan.CharacterAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakeCharacter struct {
+	mu sync.Mutex
+
+	ByIDFunc       func(ctx context.Context, id jikan.ID) (*jikan.Character, error)
+	ByIDCalls      []FakeCharacterByIDCall
+	ByIDRawFunc    func(ctx context.Context, id jikan.ID) (*jikan.Character, json.RawMessage, error)
+	ByIDRawCalls   []FakeCharacterByIDRawCall
+	ByIDsFunc      func(ctx context.Context, ids []jikan.ID, opts jikan.BatchOptions) *jikan.BatchResult[jikan.Character]
+	ByIDsCalls     []FakeCharacterByIDsCall
+	FullFunc       func(ctx context.Context, id jikan.ID) (*jikan.CharacterFull, error)
+	FullCalls      []FakeCharacterFullCall
+	AnimeFunc      func(ctx context.Context, id jikan.ID) ([]jikan.CharacterAnime, error)
+	AnimeCalls     []FakeCharacterAnimeCall
+	MangaFunc      func(ctx context.Context, id jikan.ID) ([]jikan.CharacterManga, error)
+	MangaCalls     []FakeCharacterMangaCall
+	VoicesFunc     func(ctx context.Context, id jikan.ID) ([]jikan.CharacterVoice, error)
+	VoicesCalls    []FakeCharacterVoicesCall
+	PicturesFunc   func(ctx context.Context, id jikan.ID) ([]jikan.CharacterPicture, error)
+	PicturesCalls  []FakeCharacterPicturesCall
+	SearchFunc     func(ctx context.Context, query string, page int) ([]*jikan.Character, *jikan.Pagination, error)
+	SearchCalls    []FakeCharacterSearchCall
+	SearchAllFunc  func(ctx context.Context, query string, pageOpts ...jikan.PageOption) iter.Seq2[*jikan.Character, error]
+	SearchAllCalls []FakeCharacterSearchAllCall
+}
+
+var _ jikan.CharacterAPI = (*FakeCharacter)(nil)
+
+// FakeCharacterByIDCall holds the arguments of one FakeCharacter.ByID call.
+type FakeCharacterByIDCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeCharacter) ByID(ctx context.Context, id jikan.ID) (*jikan.Character, error) {
+	f.mu.Lock()
+	f.ByIDCalls = append(f.ByIDCalls, FakeCharacterByIDCall{ctx, id})
+	fn := f.ByIDFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.Character
+	var r1 error
+	return r0, r1
+}
+
+// FakeCharacterByIDRawCall holds the arguments of one FakeCharacter.ByIDRaw call.
+type FakeCharacterByIDRawCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeCharacter) ByIDRaw(ctx context.Context, id jikan.ID) (*jikan.Character, json.RawMessage, error) {
+	f.mu.Lock()
+	f.ByIDRawCalls = append(f.ByIDRawCalls, FakeCharacterByIDRawCall{ctx, id})
+	fn := f.ByIDRawFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.Character
+	var r1 json.RawMessage
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeCharacterByIDsCall holds the arguments of one FakeCharacter.ByIDs call.
+type FakeCharacterByIDsCall struct {
+	Ctx  context.Context
+	IDs  []jikan.ID
+	Opts jikan.BatchOptions
+}
+
+func (f *FakeCharacter) ByIDs(ctx context.Context, ids []jikan.ID, opts jikan.BatchOptions) *jikan.BatchResult[jikan.Character] {
+	f.mu.Lock()
+	f.ByIDsCalls = append(f.ByIDsCalls, FakeCharacterByIDsCall{ctx, ids, opts})
+	fn := f.ByIDsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, ids, opts)
+	}
+	return &jikan.BatchResult[jikan.Character]{Items: map[jikan.ID]*jikan.Character{}, Errors: map[jikan.ID]error{}}
+}
+
+// FakeCharacterFullCall holds the arguments of one FakeCharacter.Full call.
+type FakeCharacterFullCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeCharacter) Full(ctx context.Context, id jikan.ID) (*jikan.CharacterFull, error) {
+	f.mu.Lock()
+	f.FullCalls = append(f.FullCalls, FakeCharacterFullCall{ctx, id})
+	fn := f.FullFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.CharacterFull
+	var r1 error
+	return r0, r1
+}
+
+// FakeCharacterAnimeCall holds the arguments of one FakeCharacter.Anime call.
+type FakeCharacterAnimeCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeCharacter) Anime(ctx context.Context, id jikan.ID) ([]jikan.CharacterAnime, error) {
+	f.mu.Lock()
+	f.AnimeCalls = append(f.AnimeCalls, FakeCharacterAnimeCall{ctx, id})
+	fn := f.AnimeFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.CharacterAnime
+	var r1 error
+	return r0, r1
+}
+
+// FakeCharacterMangaCall holds the arguments of one FakeCharacter.Manga call.
+type FakeCharacterMangaCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeCharacter) Manga(ctx context.Context, id jikan.ID) ([]jikan.CharacterManga, error) {
+	f.mu.Lock()
+	f.MangaCalls = append(f.MangaCalls, FakeCharacterMangaCall{ctx, id})
+	fn := f.MangaFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.CharacterManga
+	var r1 error
+	return r0, r1
+}
+
+// FakeCharacterVoicesCall holds the arguments of one FakeCharacter.Voices call.
+type FakeCharacterVoicesCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeCharacter) Voices(ctx context.Context, id jikan.ID) ([]jikan.CharacterVoice, error) {
+	f.mu.Lock()
+	f.VoicesCalls = append(f.VoicesCalls, FakeCharacterVoicesCall{ctx, id})
+	fn := f.VoicesFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.CharacterVoice
+	var r1 error
+	return r0, r1
+}
+
+// FakeCharacterPicturesCall holds the arguments of one FakeCharacter.Pictures call.
+type FakeCharacterPicturesCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeCharacter) Pictures(ctx context.Context, id jikan.ID) ([]jikan.CharacterPicture, error) {
+	f.mu.Lock()
+	f.PicturesCalls = append(f.PicturesCalls, FakeCharacterPicturesCall{ctx, id})
+	fn := f.PicturesFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.CharacterPicture
+	var r1 error
+	return r0, r1
+}
+
+// FakeCharacterSearchCall holds the arguments of one FakeCharacter.Search call.
+type FakeCharacterSearchCall struct {
+	Ctx   context.Context
+	Query string
+	Page  int
+}
+
+func (f *FakeCharacter) Search(ctx context.Context, query string, page int) ([]*jikan.Character, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.SearchCalls = append(f.SearchCalls, FakeCharacterSearchCall{ctx, query, page})
+	fn := f.SearchFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, query, page)
+	}
+	var r0 []*jikan.Character
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeCharacterSearchAllCall holds the arguments of one FakeCharacter.SearchAll call.
+type FakeCharacterSearchAllCall struct {
+	Ctx      context.Context
+	Query    string
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeCharacter) SearchAll(ctx context.Context, query string, pageOpts ...jikan.PageOption) iter.Seq2[*jikan.Character, error] {
+	f.mu.Lock()
+	f.SearchAllCalls = append(f.SearchAllCalls, FakeCharacterSearchAllCall{ctx, query, pageOpts})
+	fn := f.SearchAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, query, pageOpts...)
+	}
+	return func(func(*jikan.Character, error) bool) {}
+}
+
+// FakePeople is an in-memory jikan.PeopleAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakePeople struct {
+	mu sync.Mutex
+
+	ByIDFunc     func(ctx context.Context, id jikan.ID) (*jikan.Person, error)
+	ByIDCalls    []FakePeopleByIDCall
+	ByIDRawFunc  func(ctx context.Context, id jikan.ID) (*jikan.Person, json.RawMessage, error)
+	ByIDRawCalls []FakePeopleByIDRawCall
+	ByIDsFunc    func(ctx context.Context, ids []jikan.ID, opts jikan.BatchOptions) *jikan.BatchResult[jikan.Person]
+	ByIDsCalls   []FakePeopleByIDsCall
+}
+
+var _ jikan.PeopleAPI = (*FakePeople)(nil)
+
+// FakePeopleByIDCall holds the arguments of one FakePeople.ByID call.
+type FakePeopleByIDCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakePeople) ByID(ctx context.Context, id jikan.ID) (*jikan.Person, error) {
+	f.mu.Lock()
+	f.ByIDCalls = append(f.ByIDCalls, FakePeopleByIDCall{ctx, id})
+	fn := f.ByIDFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.Person
+	var r1 error
+	return r0, r1
+}
+
+// FakePeopleByIDRawCall holds the arguments of one FakePeople.ByIDRaw call.
+type FakePeopleByIDRawCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakePeople) ByIDRaw(ctx context.Context, id jikan.ID) (*jikan.Person, json.RawMessage, error) {
+	f.mu.Lock()
+	f.ByIDRawCalls = append(f.ByIDRawCalls, FakePeopleByIDRawCall{ctx, id})
+	fn := f.ByIDRawFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.Person
+	var r1 json.RawMessage
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakePeopleByIDsCall holds the arguments of one FakePeople.ByIDs call.
+type FakePeopleByIDsCall struct {
+	Ctx  context.Context
+	IDs  []jikan.ID
+	Opts jikan.BatchOptions
+}
+
+func (f *FakePeople) ByIDs(ctx context.Context, ids []jikan.ID, opts jikan.BatchOptions) *jikan.BatchResult[jikan.Person] {
+	f.mu.Lock()
+	f.ByIDsCalls = append(f.ByIDsCalls, FakePeopleByIDsCall{ctx, ids, opts})
+	fn := f.ByIDsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, ids, opts)
+	}
+	return &jikan.BatchResult[jikan.Person]{Items: map[jikan.ID]*jikan.Person{}, Errors: map[jikan.ID]error{}}
+}
+
+// FakeUser is an in-memory jikan.UserAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakeUser struct {
+	mu sync.Mutex
+
+	ByIDFunc                func(ctx context.Context, username string) (*jikan.User, error)
+	ByIDCalls               []FakeUserByIDCall
+	ByIDRawFunc             func(ctx context.Context, username string) (*jikan.User, json.RawMessage, error)
+	ByIDRawCalls            []FakeUserByIDRawCall
+	FullFunc                func(ctx context.Context, username string) (*jikan.UserFull, error)
+	FullCalls               []FakeUserFullCall
+	ByMalIDFunc             func(ctx context.Context, id jikan.ID) (*jikan.UserRef, error)
+	ByMalIDCalls            []FakeUserByMalIDCall
+	SearchFunc              func(ctx context.Context, opts jikan.UserSearchOptions) ([]jikan.UserSearchResult, *jikan.Pagination, error)
+	SearchCalls             []FakeUserSearchCall
+	SearchAllFunc           func(ctx context.Context, opts jikan.UserSearchOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.UserSearchResult, error]
+	SearchAllCalls          []FakeUserSearchAllCall
+	StatisticsFunc          func(ctx context.Context, username string) (*jikan.UserStatistics, error)
+	StatisticsCalls         []FakeUserStatisticsCall
+	AboutFunc               func(ctx context.Context, username string) (string, error)
+	AboutCalls              []FakeUserAboutCall
+	HistoryFunc             func(ctx context.Context, username string, filter string, page int) ([]jikan.UserHistory, *jikan.Pagination, error)
+	HistoryCalls            []FakeUserHistoryCall
+	HistoryAllFunc          func(ctx context.Context, username string, filter string, pageOpts ...jikan.PageOption) iter.Seq2[jikan.UserHistory, error]
+	HistoryAllCalls         []FakeUserHistoryAllCall
+	FriendsFunc             func(ctx context.Context, username string, page int) ([]jikan.UserFriend, *jikan.Pagination, error)
+	FriendsCalls            []FakeUserFriendsCall
+	FriendsAllFunc          func(ctx context.Context, username string, pageOpts ...jikan.PageOption) iter.Seq2[jikan.UserFriend, error]
+	FriendsAllCalls         []FakeUserFriendsAllCall
+	FavoritesFunc           func(ctx context.Context, username string) (*jikan.UserFavorites, error)
+	FavoritesCalls          []FakeUserFavoritesCall
+	UpdatesFunc             func(ctx context.Context, username string) (*jikan.UserUpdates, error)
+	UpdatesCalls            []FakeUserUpdatesCall
+	ReviewsFunc             func(ctx context.Context, username string, page int) ([]jikan.UserReview, *jikan.Pagination, error)
+	ReviewsCalls            []FakeUserReviewsCall
+	ReviewsAllFunc          func(ctx context.Context, username string, pageOpts ...jikan.PageOption) iter.Seq2[jikan.UserReview, error]
+	ReviewsAllCalls         []FakeUserReviewsAllCall
+	RecommendationsFunc     func(ctx context.Context, username string, page int) ([]jikan.Recommendation, *jikan.Pagination, error)
+	RecommendationsCalls    []FakeUserRecommendationsCall
+	RecommendationsAllFunc  func(ctx context.Context, username string, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Recommendation, error]
+	RecommendationsAllCalls []FakeUserRecommendationsAllCall
+	ClubsFunc               func(ctx context.Context, username string, page int) ([]jikan.UserClub, *jikan.Pagination, error)
+	ClubsCalls              []FakeUserClubsCall
+	ClubsAllFunc            func(ctx context.Context, username string, pageOpts ...jikan.PageOption) iter.Seq2[jikan.UserClub, error]
+	ClubsAllCalls           []FakeUserClubsAllCall
+	ExternalFunc            func(ctx context.Context, username string) ([]jikan.ExternalLink, error)
+	ExternalCalls           []FakeUserExternalCall
+}
+
+var _ jikan.UserAPI = (*FakeUser)(nil)
+
+// FakeUserByIDCall holds the arguments of one FakeUser.ByID call.
+type FakeUserByIDCall struct {
+	Ctx      context.Context
+	Username string
+}
+
+func (f *FakeUser) ByID(ctx context.Context, username string) (*jikan.User, error) {
+	f.mu.Lock()
+	f.ByIDCalls = append(f.ByIDCalls, FakeUserByIDCall{ctx, username})
+	fn := f.ByIDFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username)
+	}
+	var r0 *jikan.User
+	var r1 error
+	return r0, r1
+}
+
+// FakeUserByIDRawCall holds the arguments of one FakeUser.ByIDRaw call.
+type FakeUserByIDRawCall struct {
+	Ctx      context.Context
+	Username string
+}
+
+func (f *FakeUser) ByIDRaw(ctx context.Context, username string) (*jikan.User, json.RawMessage, error) {
+	f.mu.Lock()
+	f.ByIDRawCalls = append(f.ByIDRawCalls, FakeUserByIDRawCall{ctx, username})
+	fn := f.ByIDRawFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username)
+	}
+	var r0 *jikan.User
+	var r1 json.RawMessage
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeUserFullCall holds the arguments of one FakeUser.Full call.
+type FakeUserFullCall struct {
+	Ctx      context.Context
+	Username string
+}
+
+func (f *FakeUser) Full(ctx context.Context, username string) (*jikan.UserFull, error) {
+	f.mu.Lock()
+	f.FullCalls = append(f.FullCalls, FakeUserFullCall{ctx, username})
+	fn := f.FullFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username)
+	}
+	var r0 *jikan.UserFull
+	var r1 error
+	return r0, r1
+}
+
+// FakeUserByMalIDCall holds the arguments of one FakeUser.ByMalID call.
+type FakeUserByMalIDCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeUser) ByMalID(ctx context.Context, id jikan.ID) (*jikan.UserRef, error) {
+	f.mu.Lock()
+	f.ByMalIDCalls = append(f.ByMalIDCalls, FakeUserByMalIDCall{ctx, id})
+	fn := f.ByMalIDFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.UserRef
+	var r1 error
+	return r0, r1
+}
+
+// FakeUserSearchCall holds the arguments of one FakeUser.Search call.
+type FakeUserSearchCall struct {
+	Ctx  context.Context
+	Opts jikan.UserSearchOptions
+}
+
+func (f *FakeUser) Search(ctx context.Context, opts jikan.UserSearchOptions) ([]jikan.UserSearchResult, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.SearchCalls = append(f.SearchCalls, FakeUserSearchCall{ctx, opts})
+	fn := f.SearchFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts)
+	}
+	var r0 []jikan.UserSearchResult
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeUserSearchAllCall holds the arguments of one FakeUser.SearchAll call.
+type FakeUserSearchAllCall struct {
+	Ctx      context.Context
+	Opts     jikan.UserSearchOptions
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeUser) SearchAll(ctx context.Context, opts jikan.UserSearchOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.UserSearchResult, error] {
+	f.mu.Lock()
+	f.SearchAllCalls = append(f.SearchAllCalls, FakeUserSearchAllCall{ctx, opts, pageOpts})
+	fn := f.SearchAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts, pageOpts...)
+	}
+	return func(func(jikan.UserSearchResult, error) bool) {}
+}
+
+// FakeUserStatisticsCall holds the arguments of one FakeUser.Statistics call.
+type FakeUserStatisticsCall struct {
+	Ctx      context.Context
+	Username string
+}
+
+func (f *FakeUser) Statistics(ctx context.Context, username string) (*jikan.UserStatistics, error) {
+	f.mu.Lock()
+	f.StatisticsCalls = append(f.StatisticsCalls, FakeUserStatisticsCall{ctx, username})
+	fn := f.StatisticsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username)
+	}
+	var r0 *jikan.UserStatistics
+	var r1 error
+	return r0, r1
+}
+
+// FakeUserAboutCall holds the arguments of one FakeUser.About call.
+type FakeUserAboutCall struct {
+	Ctx      context.Context
+	Username string
+}
+
+func (f *FakeUser) About(ctx context.Context, username string) (string, error) {
+	f.mu.Lock()
+	f.AboutCalls = append(f.AboutCalls, FakeUserAboutCall{ctx, username})
+	fn := f.AboutFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username)
+	}
+	var r0 string
+	var r1 error
+	return r0, r1
+}
+
+// FakeUserHistoryCall holds the arguments of one FakeUser.History call.
+type FakeUserHistoryCall struct {
+	Ctx      context.Context
+	Username string
+	Filter   string
+	Page     int
+}
+
+func (f *FakeUser) History(ctx context.Context, username string, filter string, page int) ([]jikan.UserHistory, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.HistoryCalls = append(f.HistoryCalls, FakeUserHistoryCall{ctx, username, filter, page})
+	fn := f.HistoryFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username, filter, page)
+	}
+	var r0 []jikan.UserHistory
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeUserHistoryAllCall holds the arguments of one FakeUser.HistoryAll call.
+type FakeUserHistoryAllCall struct {
+	Ctx      context.Context
+	Username string
+	Filter   string
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeUser) HistoryAll(ctx context.Context, username string, filter string, pageOpts ...jikan.PageOption) iter.Seq2[jikan.UserHistory, error] {
+	f.mu.Lock()
+	f.HistoryAllCalls = append(f.HistoryAllCalls, FakeUserHistoryAllCall{ctx, username, filter, pageOpts})
+	fn := f.HistoryAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username, filter, pageOpts...)
+	}
+	return func(func(jikan.UserHistory, error) bool) {}
+}
+
+// FakeUserFriendsCall holds the arguments of one FakeUser.Friends call.
+type FakeUserFriendsCall struct {
+	Ctx      context.Context
+	Username string
+	Page     int
+}
+
+func (f *FakeUser) Friends(ctx context.Context, username string, page int) ([]jikan.UserFriend, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.FriendsCalls = append(f.FriendsCalls, FakeUserFriendsCall{ctx, username, page})
+	fn := f.FriendsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username, page)
+	}
+	var r0 []jikan.UserFriend
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeUserFriendsAllCall holds the arguments of one FakeUser.FriendsAll call.
+type FakeUserFriendsAllCall struct {
+	Ctx      context.Context
+	Username string
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeUser) FriendsAll(ctx context.Context, username string, pageOpts ...jikan.PageOption) iter.Seq2[jikan.UserFriend, error] {
+	f.mu.Lock()
+	f.FriendsAllCalls = append(f.FriendsAllCalls, FakeUserFriendsAllCall{ctx, username, pageOpts})
+	fn := f.FriendsAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username, pageOpts...)
+	}
+	return func(func(jikan.UserFriend, error) bool) {}
+}
+
+// FakeUserFavoritesCall holds the arguments of one FakeUser.Favorites call.
+type FakeUserFavoritesCall struct {
+	Ctx      context.Context
+	Username string
+}
+
+func (f *FakeUser) Favorites(ctx context.Context, username string) (*jikan.UserFavorites, error) {
+	f.mu.Lock()
+	f.FavoritesCalls = append(f.FavoritesCalls, FakeUserFavoritesCall{ctx, username})
+	fn := f.FavoritesFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username)
+	}
+	var r0 *jikan.UserFavorites
+	var r1 error
+	return r0, r1
+}
+
+// FakeUserUpdatesCall holds the arguments of one FakeUser.Updates call.
+type FakeUserUpdatesCall struct {
+	Ctx      context.Context
+	Username string
+}
+
+func (f *FakeUser) Updates(ctx context.Context, username string) (*jikan.UserUpdates, error) {
+	f.mu.Lock()
+	f.UpdatesCalls = append(f.UpdatesCalls, FakeUserUpdatesCall{ctx, username})
+	fn := f.UpdatesFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username)
+	}
+	var r0 *jikan.UserUpdates
+	var r1 error
+	return r0, r1
+}
+
+// FakeUserReviewsCall holds the arguments of one FakeUser.Reviews call.
+type FakeUserReviewsCall struct {
+	Ctx      context.Context
+	Username string
+	Page     int
+}
+
+func (f *FakeUser) Reviews(ctx context.Context, username string, page int) ([]jikan.UserReview, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.ReviewsCalls = append(f.ReviewsCalls, FakeUserReviewsCall{ctx, username, page})
+	fn := f.ReviewsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username, page)
+	}
+	var r0 []jikan.UserReview
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeUserReviewsAllCall holds the arguments of one FakeUser.ReviewsAll call.
+type FakeUserReviewsAllCall struct {
+	Ctx      context.Context
+	Username string
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeUser) ReviewsAll(ctx context.Context, username string, pageOpts ...jikan.PageOption) iter.Seq2[jikan.UserReview, error] {
+	f.mu.Lock()
+	f.ReviewsAllCalls = append(f.ReviewsAllCalls, FakeUserReviewsAllCall{ctx, username, pageOpts})
+	fn := f.ReviewsAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username, pageOpts...)
+	}
+	return func(func(jikan.UserReview, error) bool) {}
+}
+
+// FakeUserRecommendationsCall holds the arguments of one FakeUser.Recommendations call.
+type FakeUserRecommendationsCall struct {
+	Ctx      context.Context
+	Username string
+	Page     int
+}
+
+func (f *FakeUser) Recommendations(ctx context.Context, username string, page int) ([]jikan.Recommendation, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.RecommendationsCalls = append(f.RecommendationsCalls, FakeUserRecommendationsCall{ctx, username, page})
+	fn := f.RecommendationsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username, page)
+	}
+	var r0 []jikan.Recommendation
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeUserRecommendationsAllCall holds the arguments of one FakeUser.RecommendationsAll call.
+type FakeUserRecommendationsAllCall struct {
+	Ctx      context.Context
+	Username string
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeUser) RecommendationsAll(ctx context.Context, username string, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Recommendation, error] {
+	f.mu.Lock()
+	f.RecommendationsAllCalls = append(f.RecommendationsAllCalls, FakeUserRecommendationsAllCall{ctx, username, pageOpts})
+	fn := f.RecommendationsAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username, pageOpts...)
+	}
+	return func(func(jikan.Recommendation, error) bool) {}
+}
+
+// FakeUserClubsCall holds the arguments of one FakeUser.Clubs call.
+type FakeUserClubsCall struct {
+	Ctx      context.Context
+	Username string
+	Page     int
+}
+
+func (f *FakeUser) Clubs(ctx context.Context, username string, page int) ([]jikan.UserClub, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.ClubsCalls = append(f.ClubsCalls, FakeUserClubsCall{ctx, username, page})
+	fn := f.ClubsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username, page)
+	}
+	var r0 []jikan.UserClub
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeUserClubsAllCall holds the arguments of one FakeUser.ClubsAll call.
+type FakeUserClubsAllCall struct {
+	Ctx      context.Context
+	Username string
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeUser) ClubsAll(ctx context.Context, username string, pageOpts ...jikan.PageOption) iter.Seq2[jikan.UserClub, error] {
+	f.mu.Lock()
+	f.ClubsAllCalls = append(f.ClubsAllCalls, FakeUserClubsAllCall{ctx, username, pageOpts})
+	fn := f.ClubsAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username, pageOpts...)
+	}
+	return func(func(jikan.UserClub, error) bool) {}
+}
+
+// FakeUserExternalCall holds the arguments of one FakeUser.External call.
+type FakeUserExternalCall struct {
+	Ctx      context.Context
+	Username string
+}
+
+func (f *FakeUser) External(ctx context.Context, username string) ([]jikan.ExternalLink, error) {
+	f.mu.Lock()
+	f.ExternalCalls = append(f.ExternalCalls, FakeUserExternalCall{ctx, username})
+	fn := f.ExternalFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, username)
+	}
+	var r0 []jikan.ExternalLink
+	var r1 error
+	return r0, r1
+}
+
+// FakeSeason is an in-memory jikan.SeasonAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakeSeason struct {
+	mu sync.Mutex
+
+	ListFunc         func(ctx context.Context) ([]jikan.SeasonArchive, error)
+	ListCalls        []FakeSeasonListCall
+	NowFunc          func(ctx context.Context, opts jikan.SeasonOptions) ([]jikan.Anime, *jikan.Pagination, error)
+	NowCalls         []FakeSeasonNowCall
+	NowAllFunc       func(ctx context.Context, opts jikan.SeasonOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Anime, error]
+	NowAllCalls      []FakeSeasonNowAllCall
+	ArchiveFunc      func(ctx context.Context, year int, season jikan.Season, opts jikan.SeasonOptions) ([]jikan.Anime, *jikan.Pagination, error)
+	ArchiveCalls     []FakeSeasonArchiveCall
+	ArchiveAllFunc   func(ctx context.Context, year int, season jikan.Season, opts jikan.SeasonOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Anime, error]
+	ArchiveAllCalls  []FakeSeasonArchiveAllCall
+	UpcomingFunc     func(ctx context.Context, opts jikan.SeasonOptions) ([]jikan.Anime, *jikan.Pagination, error)
+	UpcomingCalls    []FakeSeasonUpcomingCall
+	UpcomingAllFunc  func(ctx context.Context, opts jikan.SeasonOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Anime, error]
+	UpcomingAllCalls []FakeSeasonUpcomingAllCall
+}
+
+var _ jikan.SeasonAPI = (*FakeSeason)(nil)
+
+// FakeSeasonListCall holds the arguments of one FakeSeason.List call.
+type FakeSeasonListCall struct {
+	Ctx context.Context
+}
+
+func (f *FakeSeason) List(ctx context.Context) ([]jikan.SeasonArchive, error) {
+	f.mu.Lock()
+	f.ListCalls = append(f.ListCalls, FakeSeasonListCall{ctx})
+	fn := f.ListFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx)
+	}
+	var r0 []jikan.SeasonArchive
+	var r1 error
+	return r0, r1
+}
+
+// FakeSeasonNowCall holds the arguments of one FakeSeason.Now call.
+type FakeSeasonNowCall struct {
+	Ctx  context.Context
+	Opts jikan.SeasonOptions
+}
+
+func (f *FakeSeason) Now(ctx context.Context, opts jikan.SeasonOptions) ([]jikan.Anime, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.NowCalls = append(f.NowCalls, FakeSeasonNowCall{ctx, opts})
+	fn := f.NowFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts)
+	}
+	var r0 []jikan.Anime
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeSeasonNowAllCall holds the arguments of one FakeSeason.NowAll call.
+type FakeSeasonNowAllCall struct {
+	Ctx      context.Context
+	Opts     jikan.SeasonOptions
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeSeason) NowAll(ctx context.Context, opts jikan.SeasonOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Anime, error] {
+	f.mu.Lock()
+	f.NowAllCalls = append(f.NowAllCalls, FakeSeasonNowAllCall{ctx, opts, pageOpts})
+	fn := f.NowAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts, pageOpts...)
+	}
+	return func(func(jikan.Anime, error) bool) {}
+}
+
+// FakeSeasonArchiveCall holds the arguments of one FakeSeason.Archive call.
+type FakeSeasonArchiveCall struct {
+	Ctx    context.Context
+	Year   int
+	Season jikan.Season
+	Opts   jikan.SeasonOptions
+}
+
+func (f *FakeSeason) Archive(ctx context.Context, year int, season jikan.Season, opts jikan.SeasonOptions) ([]jikan.Anime, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.ArchiveCalls = append(f.ArchiveCalls, FakeSeasonArchiveCall{ctx, year, season, opts})
+	fn := f.ArchiveFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, year, season, opts)
+	}
+	var r0 []jikan.Anime
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeSeasonArchiveAllCall holds the arguments of one FakeSeason.ArchiveAll call.
+type FakeSeasonArchiveAllCall struct {
+	Ctx      context.Context
+	Year     int
+	Season   jikan.Season
+	Opts     jikan.SeasonOptions
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeSeason) ArchiveAll(ctx context.Context, year int, season jikan.Season, opts jikan.SeasonOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Anime, error] {
+	f.mu.Lock()
+	f.ArchiveAllCalls = append(f.ArchiveAllCalls, FakeSeasonArchiveAllCall{ctx, year, season, opts, pageOpts})
+	fn := f.ArchiveAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, year, season, opts, pageOpts...)
+	}
+	return func(func(jikan.Anime, error) bool) {}
+}
+
+// FakeSeasonUpcomingCall holds the arguments of one FakeSeason.Upcoming call.
+type FakeSeasonUpcomingCall struct {
+	Ctx  context.Context
+	Opts jikan.SeasonOptions
+}
+
+func (f *FakeSeason) Upcoming(ctx context.Context, opts jikan.SeasonOptions) ([]jikan.Anime, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.UpcomingCalls = append(f.UpcomingCalls, FakeSeasonUpcomingCall{ctx, opts})
+	fn := f.UpcomingFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts)
+	}
+	var r0 []jikan.Anime
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeSeasonUpcomingAllCall holds the arguments of one FakeSeason.UpcomingAll call.
+type FakeSeasonUpcomingAllCall struct {
+	Ctx      context.Context
+	Opts     jikan.SeasonOptions
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeSeason) UpcomingAll(ctx context.Context, opts jikan.SeasonOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Anime, error] {
+	f.mu.Lock()
+	f.UpcomingAllCalls = append(f.UpcomingAllCalls, FakeSeasonUpcomingAllCall{ctx, opts, pageOpts})
+	fn := f.UpcomingAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts, pageOpts...)
+	}
+	return func(func(jikan.Anime, error) bool) {}
+}
+
+// FakeTop is an in-memory jikan.TopAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakeTop struct {
+	mu sync.Mutex
+
+	AnimeFunc          func(ctx context.Context, opts jikan.TopAnimeOptions) ([]jikan.Anime, *jikan.Pagination, error)
+	AnimeCalls         []FakeTopAnimeCall
+	AnimeAllFunc       func(ctx context.Context, opts jikan.TopAnimeOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Anime, error]
+	AnimeAllCalls      []FakeTopAnimeAllCall
+	MangaFunc          func(ctx context.Context, opts jikan.TopMangaOptions) ([]jikan.Manga, *jikan.Pagination, error)
+	MangaCalls         []FakeTopMangaCall
+	MangaAllFunc       func(ctx context.Context, opts jikan.TopMangaOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Manga, error]
+	MangaAllCalls      []FakeTopMangaAllCall
+	PeopleFunc         func(ctx context.Context, page int) ([]jikan.Person, *jikan.Pagination, error)
+	PeopleCalls        []FakeTopPeopleCall
+	PeopleAllFunc      func(ctx context.Context, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Person, error]
+	PeopleAllCalls     []FakeTopPeopleAllCall
+	CharactersFunc     func(ctx context.Context, page int) ([]jikan.Character, *jikan.Pagination, error)
+	CharactersCalls    []FakeTopCharactersCall
+	CharactersAllFunc  func(ctx context.Context, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Character, error]
+	CharactersAllCalls []FakeTopCharactersAllCall
+	ReviewsFunc        func(ctx context.Context, opts jikan.TopReviewsOptions) ([]jikan.Review, *jikan.Pagination, error)
+	ReviewsCalls       []FakeTopReviewsCall
+	ReviewsAllFunc     func(ctx context.Context, opts jikan.TopReviewsOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Review, error]
+	ReviewsAllCalls    []FakeTopReviewsAllCall
+}
+
+var _ jikan.TopAPI = (*FakeTop)(nil)
+
+// FakeTopAnimeCall holds the arguments of one FakeTop.Anime call.
+type FakeTopAnimeCall struct {
+	Ctx  context.Context
+	Opts jikan.TopAnimeOptions
+}
+
+func (f *FakeTop) Anime(ctx context.Context, opts jikan.TopAnimeOptions) ([]jikan.Anime, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.AnimeCalls = append(f.AnimeCalls, FakeTopAnimeCall{ctx, opts})
+	fn := f.AnimeFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts)
+	}
+	var r0 []jikan.Anime
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeTopAnimeAllCall holds the arguments of one FakeTop.AnimeAll call.
+type FakeTopAnimeAllCall struct {
+	Ctx      context.Context
+	Opts     jikan.TopAnimeOptions
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeTop) AnimeAll(ctx context.Context, opts jikan.TopAnimeOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Anime, error] {
+	f.mu.Lock()
+	f.AnimeAllCalls = append(f.AnimeAllCalls, FakeTopAnimeAllCall{ctx, opts, pageOpts})
+	fn := f.AnimeAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts, pageOpts...)
+	}
+	return func(func(jikan.Anime, error) bool) {}
+}
+
+// FakeTopMangaCall holds the arguments of one FakeTop.Manga call.
+type FakeTopMangaCall struct {
+	Ctx  context.Context
+	Opts jikan.TopMangaOptions
+}
+
+func (f *FakeTop) Manga(ctx context.Context, opts jikan.TopMangaOptions) ([]jikan.Manga, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.MangaCalls = append(f.MangaCalls, FakeTopMangaCall{ctx, opts})
+	fn := f.MangaFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts)
+	}
+	var r0 []jikan.Manga
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeTopMangaAllCall holds the arguments of one FakeTop.MangaAll call.
+type FakeTopMangaAllCall struct {
+	Ctx      context.Context
+	Opts     jikan.TopMangaOptions
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeTop) MangaAll(ctx context.Context, opts jikan.TopMangaOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Manga, error] {
+	f.mu.Lock()
+	f.MangaAllCalls = append(f.MangaAllCalls, FakeTopMangaAllCall{ctx, opts, pageOpts})
+	fn := f.MangaAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts, pageOpts...)
+	}
+	return func(func(jikan.Manga, error) bool) {}
+}
+
+// FakeTopPeopleCall holds the arguments of one FakeTop.People call.
+type FakeTopPeopleCall struct {
+	Ctx  context.Context
+	Page int
+}
+
+func (f *FakeTop) People(ctx context.Context, page int) ([]jikan.Person, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.PeopleCalls = append(f.PeopleCalls, FakeTopPeopleCall{ctx, page})
+	fn := f.PeopleFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, page)
+	}
+	var r0 []jikan.Person
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeTopPeopleAllCall holds the arguments of one FakeTop.PeopleAll call.
+type FakeTopPeopleAllCall struct {
+	Ctx      context.Context
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeTop) PeopleAll(ctx context.Context, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Person, error] {
+	f.mu.Lock()
+	f.PeopleAllCalls = append(f.PeopleAllCalls, FakeTopPeopleAllCall{ctx, pageOpts})
+	fn := f.PeopleAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, pageOpts...)
+	}
+	return func(func(jikan.Person, error) bool) {}
+}
+
+// FakeTopCharactersCall holds the arguments of one FakeTop.Characters call.
+type FakeTopCharactersCall struct {
+	Ctx  context.Context
+	Page int
+}
+
+func (f *FakeTop) Characters(ctx context.Context, page int) ([]jikan.Character, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.CharactersCalls = append(f.CharactersCalls, FakeTopCharactersCall{ctx, page})
+	fn := f.CharactersFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, page)
+	}
+	var r0 []jikan.Character
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeTopCharactersAllCall holds the arguments of one FakeTop.CharactersAll call.
+type FakeTopCharactersAllCall struct {
+	Ctx      context.Context
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeTop) CharactersAll(ctx context.Context, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Character, error] {
+	f.mu.Lock()
+	f.CharactersAllCalls = append(f.CharactersAllCalls, FakeTopCharactersAllCall{ctx, pageOpts})
+	fn := f.CharactersAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, pageOpts...)
+	}
+	return func(func(jikan.Character, error) bool) {}
+}
+
+// FakeTopReviewsCall holds the arguments of one FakeTop.Reviews call.
+type FakeTopReviewsCall struct {
+	Ctx  context.Context
+	Opts jikan.TopReviewsOptions
+}
+
+func (f *FakeTop) Reviews(ctx context.Context, opts jikan.TopReviewsOptions) ([]jikan.Review, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.ReviewsCalls = append(f.ReviewsCalls, FakeTopReviewsCall{ctx, opts})
+	fn := f.ReviewsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts)
+	}
+	var r0 []jikan.Review
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeTopReviewsAllCall holds the arguments of one FakeTop.ReviewsAll call.
+type FakeTopReviewsAllCall struct {
+	Ctx      context.Context
+	Opts     jikan.TopReviewsOptions
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeTop) ReviewsAll(ctx context.Context, opts jikan.TopReviewsOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Review, error] {
+	f.mu.Lock()
+	f.ReviewsAllCalls = append(f.ReviewsAllCalls, FakeTopReviewsAllCall{ctx, opts, pageOpts})
+	fn := f.ReviewsAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts, pageOpts...)
+	}
+	return func(func(jikan.Review, error) bool) {}
+}
+
+// FakeProducer is an in-memory jikan.ProducerAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakeProducer struct {
+	mu sync.Mutex
+
+	ByIDFunc       func(ctx context.Context, id jikan.ID) (*jikan.Producer, error)
+	ByIDCalls      []FakeProducerByIDCall
+	ByIDRawFunc    func(ctx context.Context, id jikan.ID) (*jikan.Producer, json.RawMessage, error)
+	ByIDRawCalls   []FakeProducerByIDRawCall
+	ByIDsFunc      func(ctx context.Context, ids []jikan.ID, opts jikan.BatchOptions) *jikan.BatchResult[jikan.Producer]
+	ByIDsCalls     []FakeProducerByIDsCall
+	FullFunc       func(ctx context.Context, id jikan.ID) (*jikan.ProducerFull, error)
+	FullCalls      []FakeProducerFullCall
+	ExternalFunc   func(ctx context.Context, id jikan.ID) ([]jikan.ExternalLink, error)
+	ExternalCalls  []FakeProducerExternalCall
+	SearchFunc     func(ctx context.Context, opts jikan.ProducerSearchOptions) ([]jikan.Producer, *jikan.Pagination, error)
+	SearchCalls    []FakeProducerSearchCall
+	SearchAllFunc  func(ctx context.Context, opts jikan.ProducerSearchOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Producer, error]
+	SearchAllCalls []FakeProducerSearchAllCall
+}
+
+var _ jikan.ProducerAPI = (*FakeProducer)(nil)
+
+// FakeProducerByIDCall holds the arguments of one FakeProducer.ByID call.
+type FakeProducerByIDCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeProducer) ByID(ctx context.Context, id jikan.ID) (*jikan.Producer, error) {
+	f.mu.Lock()
+	f.ByIDCalls = append(f.ByIDCalls, FakeProducerByIDCall{ctx, id})
+	fn := f.ByIDFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.Producer
+	var r1 error
+	return r0, r1
+}
+
+// FakeProducerByIDRawCall holds the arguments of one FakeProducer.ByIDRaw call.
+type FakeProducerByIDRawCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeProducer) ByIDRaw(ctx context.Context, id jikan.ID) (*jikan.Producer, json.RawMessage, error) {
+	f.mu.Lock()
+	f.ByIDRawCalls = append(f.ByIDRawCalls, FakeProducerByIDRawCall{ctx, id})
+	fn := f.ByIDRawFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.Producer
+	var r1 json.RawMessage
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeProducerByIDsCall holds the arguments of one FakeProducer.ByIDs call.
+type FakeProducerByIDsCall struct {
+	Ctx  context.Context
+	IDs  []jikan.ID
+	Opts jikan.BatchOptions
+}
+
+func (f *FakeProducer) ByIDs(ctx context.Context, ids []jikan.ID, opts jikan.BatchOptions) *jikan.BatchResult[jikan.Producer] {
+	f.mu.Lock()
+	f.ByIDsCalls = append(f.ByIDsCalls, FakeProducerByIDsCall{ctx, ids, opts})
+	fn := f.ByIDsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, ids, opts)
+	}
+	return &jikan.BatchResult[jikan.Producer]{Items: map[jikan.ID]*jikan.Producer{}, Errors: map[jikan.ID]error{}}
+}
+
+// FakeProducerFullCall holds the arguments of one FakeProducer.Full call.
+type FakeProducerFullCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeProducer) Full(ctx context.Context, id jikan.ID) (*jikan.ProducerFull, error) {
+	f.mu.Lock()
+	f.FullCalls = append(f.FullCalls, FakeProducerFullCall{ctx, id})
+	fn := f.FullFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.ProducerFull
+	var r1 error
+	return r0, r1
+}
+
+// FakeProducerExternalCall holds the arguments of one FakeProducer.External call.
+type FakeProducerExternalCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeProducer) External(ctx context.Context, id jikan.ID) ([]jikan.ExternalLink, error) {
+	f.mu.Lock()
+	f.ExternalCalls = append(f.ExternalCalls, FakeProducerExternalCall{ctx, id})
+	fn := f.ExternalFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.ExternalLink
+	var r1 error
+	return r0, r1
+}
+
+// FakeProducerSearchCall holds the arguments of one FakeProducer.Search call.
+type FakeProducerSearchCall struct {
+	Ctx  context.Context
+	Opts jikan.ProducerSearchOptions
+}
+
+func (f *FakeProducer) Search(ctx context.Context, opts jikan.ProducerSearchOptions) ([]jikan.Producer, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.SearchCalls = append(f.SearchCalls, FakeProducerSearchCall{ctx, opts})
+	fn := f.SearchFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts)
+	}
+	var r0 []jikan.Producer
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeProducerSearchAllCall holds the arguments of one FakeProducer.SearchAll call.
+type FakeProducerSearchAllCall struct {
+	Ctx      context.Context
+	Opts     jikan.ProducerSearchOptions
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeProducer) SearchAll(ctx context.Context, opts jikan.ProducerSearchOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Producer, error] {
+	f.mu.Lock()
+	f.SearchAllCalls = append(f.SearchAllCalls, FakeProducerSearchAllCall{ctx, opts, pageOpts})
+	fn := f.SearchAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts, pageOpts...)
+	}
+	return func(func(jikan.Producer, error) bool) {}
+}
+
+// FakeMagazine is an in-memory jikan.MagazineAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakeMagazine struct {
+	mu sync.Mutex
+
+	ByIDFunc       func(ctx context.Context, id jikan.ID) (*jikan.Magazine, error)
+	ByIDCalls      []FakeMagazineByIDCall
+	ByIDRawFunc    func(ctx context.Context, id jikan.ID) (*jikan.Magazine, json.RawMessage, error)
+	ByIDRawCalls   []FakeMagazineByIDRawCall
+	SearchFunc     func(ctx context.Context, opts jikan.MagazineSearchOptions) ([]jikan.Magazine, *jikan.Pagination, error)
+	SearchCalls    []FakeMagazineSearchCall
+	SearchAllFunc  func(ctx context.Context, opts jikan.MagazineSearchOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Magazine, error]
+	SearchAllCalls []FakeMagazineSearchAllCall
+}
+
+var _ jikan.MagazineAPI = (*FakeMagazine)(nil)
+
+// FakeMagazineByIDCall holds the arguments of one FakeMagazine.ByID call.
+type FakeMagazineByIDCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeMagazine) ByID(ctx context.Context, id jikan.ID) (*jikan.Magazine, error) {
+	f.mu.Lock()
+	f.ByIDCalls = append(f.ByIDCalls, FakeMagazineByIDCall{ctx, id})
+	fn := f.ByIDFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.Magazine
+	var r1 error
+	return r0, r1
+}
+
+// FakeMagazineByIDRawCall holds the arguments of one FakeMagazine.ByIDRaw call.
+type FakeMagazineByIDRawCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeMagazine) ByIDRaw(ctx context.Context, id jikan.ID) (*jikan.Magazine, json.RawMessage, error) {
+	f.mu.Lock()
+	f.ByIDRawCalls = append(f.ByIDRawCalls, FakeMagazineByIDRawCall{ctx, id})
+	fn := f.ByIDRawFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.Magazine
+	var r1 json.RawMessage
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeMagazineSearchCall holds the arguments of one FakeMagazine.Search call.
+type FakeMagazineSearchCall struct {
+	Ctx  context.Context
+	Opts jikan.MagazineSearchOptions
+}
+
+func (f *FakeMagazine) Search(ctx context.Context, opts jikan.MagazineSearchOptions) ([]jikan.Magazine, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.SearchCalls = append(f.SearchCalls, FakeMagazineSearchCall{ctx, opts})
+	fn := f.SearchFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts)
+	}
+	var r0 []jikan.Magazine
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeMagazineSearchAllCall holds the arguments of one FakeMagazine.SearchAll call.
+type FakeMagazineSearchAllCall struct {
+	Ctx      context.Context
+	Opts     jikan.MagazineSearchOptions
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeMagazine) SearchAll(ctx context.Context, opts jikan.MagazineSearchOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Magazine, error] {
+	f.mu.Lock()
+	f.SearchAllCalls = append(f.SearchAllCalls, FakeMagazineSearchAllCall{ctx, opts, pageOpts})
+	fn := f.SearchAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, opts, pageOpts...)
+	}
+	return func(func(jikan.Magazine, error) bool) {}
+}
+
+// FakeGenre is an in-memory jikan.GenreAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakeGenre struct {
+	mu sync.Mutex
+
+	AnimeFunc     func(ctx context.Context, filter jikan.GenreFilter, page int, limit int) ([]*jikan.Genre, *jikan.Pagination, error)
+	AnimeCalls    []FakeGenreAnimeCall
+	MangaFunc     func(ctx context.Context, filter jikan.GenreFilter, page int, limit int) ([]*jikan.Genre, *jikan.Pagination, error)
+	MangaCalls    []FakeGenreMangaCall
+	AnimeAllFunc  func(ctx context.Context, filter jikan.GenreFilter, limit int, pageOpts ...jikan.PageOption) iter.Seq2[*jikan.Genre, error]
+	AnimeAllCalls []FakeGenreAnimeAllCall
+	MangaAllFunc  func(ctx context.Context, filter jikan.GenreFilter, limit int, pageOpts ...jikan.PageOption) iter.Seq2[*jikan.Genre, error]
+	MangaAllCalls []FakeGenreMangaAllCall
+}
+
+var _ jikan.GenreAPI = (*FakeGenre)(nil)
+
+// FakeGenreAnimeCall holds the arguments of one FakeGenre.Anime call.
+type FakeGenreAnimeCall struct {
+	Ctx    context.Context
+	Filter jikan.GenreFilter
+	Page   int
+	Limit  int
+}
+
+func (f *FakeGenre) Anime(ctx context.Context, filter jikan.GenreFilter, page int, limit int) ([]*jikan.Genre, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.AnimeCalls = append(f.AnimeCalls, FakeGenreAnimeCall{ctx, filter, page, limit})
+	fn := f.AnimeFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, filter, page, limit)
+	}
+	var r0 []*jikan.Genre
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeGenreMangaCall holds the arguments of one FakeGenre.Manga call.
+type FakeGenreMangaCall struct {
+	Ctx    context.Context
+	Filter jikan.GenreFilter
+	Page   int
+	Limit  int
+}
+
+func (f *FakeGenre) Manga(ctx context.Context, filter jikan.GenreFilter, page int, limit int) ([]*jikan.Genre, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.MangaCalls = append(f.MangaCalls, FakeGenreMangaCall{ctx, filter, page, limit})
+	fn := f.MangaFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, filter, page, limit)
+	}
+	var r0 []*jikan.Genre
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeGenreAnimeAllCall holds the arguments of one FakeGenre.AnimeAll call.
+type FakeGenreAnimeAllCall struct {
+	Ctx      context.Context
+	Filter   jikan.GenreFilter
+	Limit    int
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeGenre) AnimeAll(ctx context.Context, filter jikan.GenreFilter, limit int, pageOpts ...jikan.PageOption) iter.Seq2[*jikan.Genre, error] {
+	f.mu.Lock()
+	f.AnimeAllCalls = append(f.AnimeAllCalls, FakeGenreAnimeAllCall{ctx, filter, limit, pageOpts})
+	fn := f.AnimeAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, filter, limit, pageOpts...)
+	}
+	return func(func(*jikan.Genre, error) bool) {}
+}
+
+// FakeGenreMangaAllCall holds the arguments of one FakeGenre.MangaAll call.
+type FakeGenreMangaAllCall struct {
+	Ctx      context.Context
+	Filter   jikan.GenreFilter
+	Limit    int
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeGenre) MangaAll(ctx context.Context, filter jikan.GenreFilter, limit int, pageOpts ...jikan.PageOption) iter.Seq2[*jikan.Genre, error] {
+	f.mu.Lock()
+	f.MangaAllCalls = append(f.MangaAllCalls, FakeGenreMangaAllCall{ctx, filter, limit, pageOpts})
+	fn := f.MangaAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, filter, limit, pageOpts...)
+	}
+	return func(func(*jikan.Genre, error) bool) {}
+}
+
+// FakeSearch is an in-memory jikan.SearchAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakeSearch struct {
+	mu sync.Mutex
+
+	AnimeFunc     func(ctx context.Context, query string, opts jikan.AnimeSearchOptions) ([]jikan.Anime, *jikan.Pagination, error)
+	AnimeCalls    []FakeSearchAnimeCall
+	AnimeAllFunc  func(ctx context.Context, query string, opts jikan.AnimeSearchOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Anime, error]
+	AnimeAllCalls []FakeSearchAnimeAllCall
+}
+
+var _ jikan.SearchAPI = (*FakeSearch)(nil)
+
+// FakeSearchAnimeCall holds the arguments of one FakeSearch.Anime call.
+type FakeSearchAnimeCall struct {
+	Ctx   context.Context
+	Query string
+	Opts  jikan.AnimeSearchOptions
+}
+
+func (f *FakeSearch) Anime(ctx context.Context, query string, opts jikan.AnimeSearchOptions) ([]jikan.Anime, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.AnimeCalls = append(f.AnimeCalls, FakeSearchAnimeCall{ctx, query, opts})
+	fn := f.AnimeFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, query, opts)
+	}
+	var r0 []jikan.Anime
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeSearchAnimeAllCall holds the arguments of one FakeSearch.AnimeAll call.
+type FakeSearchAnimeAllCall struct {
+	Ctx      context.Context
+	Query    string
+	Opts     jikan.AnimeSearchOptions
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeSearch) AnimeAll(ctx context.Context, query string, opts jikan.AnimeSearchOptions, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Anime, error] {
+	f.mu.Lock()
+	f.AnimeAllCalls = append(f.AnimeAllCalls, FakeSearchAnimeAllCall{ctx, query, opts, pageOpts})
+	fn := f.AnimeAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, query, opts, pageOpts...)
+	}
+	return func(func(jikan.Anime, error) bool) {}
+}
+
+// FakeReview is an in-memory jikan.ReviewAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakeReview struct {
+	mu sync.Mutex
+
+	RecentFunc       func(ctx context.Context, page int) ([]jikan.Review, *jikan.Pagination, error)
+	RecentCalls      []FakeReviewRecentCall
+	RecentAllFunc    func(ctx context.Context, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Review, error]
+	RecentAllCalls   []FakeReviewRecentAllCall
+	ForAnimeFunc     func(ctx context.Context, id jikan.ID, page int) ([]jikan.Review, *jikan.Pagination, error)
+	ForAnimeCalls    []FakeReviewForAnimeCall
+	ForAnimeAllFunc  func(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Review, error]
+	ForAnimeAllCalls []FakeReviewForAnimeAllCall
+	ForMangaFunc     func(ctx context.Context, id jikan.ID, page int) ([]jikan.Review, *jikan.Pagination, error)
+	ForMangaCalls    []FakeReviewForMangaCall
+	ForMangaAllFunc  func(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Review, error]
+	ForMangaAllCalls []FakeReviewForMangaAllCall
+}
+
+var _ jikan.ReviewAPI = (*FakeReview)(nil)
+
+// FakeReviewRecentCall holds the arguments of one FakeReview.Recent call.
+type FakeReviewRecentCall struct {
+	Ctx  context.Context
+	Page int
+}
+
+func (f *FakeReview) Recent(ctx context.Context, page int) ([]jikan.Review, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.RecentCalls = append(f.RecentCalls, FakeReviewRecentCall{ctx, page})
+	fn := f.RecentFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, page)
+	}
+	var r0 []jikan.Review
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeReviewRecentAllCall holds the arguments of one FakeReview.RecentAll call.
+type FakeReviewRecentAllCall struct {
+	Ctx      context.Context
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeReview) RecentAll(ctx context.Context, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Review, error] {
+	f.mu.Lock()
+	f.RecentAllCalls = append(f.RecentAllCalls, FakeReviewRecentAllCall{ctx, pageOpts})
+	fn := f.RecentAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, pageOpts...)
+	}
+	return func(func(jikan.Review, error) bool) {}
+}
+
+// FakeReviewForAnimeCall holds the arguments of one FakeReview.ForAnime call.
+type FakeReviewForAnimeCall struct {
+	Ctx  context.Context
+	ID   jikan.ID
+	Page int
+}
+
+func (f *FakeReview) ForAnime(ctx context.Context, id jikan.ID, page int) ([]jikan.Review, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.ForAnimeCalls = append(f.ForAnimeCalls, FakeReviewForAnimeCall{ctx, id, page})
+	fn := f.ForAnimeFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, page)
+	}
+	var r0 []jikan.Review
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeReviewForAnimeAllCall holds the arguments of one FakeReview.ForAnimeAll call.
+type FakeReviewForAnimeAllCall struct {
+	Ctx      context.Context
+	ID       jikan.ID
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeReview) ForAnimeAll(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Review, error] {
+	f.mu.Lock()
+	f.ForAnimeAllCalls = append(f.ForAnimeAllCalls, FakeReviewForAnimeAllCall{ctx, id, pageOpts})
+	fn := f.ForAnimeAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, pageOpts...)
+	}
+	return func(func(jikan.Review, error) bool) {}
+}
+
+// FakeReviewForMangaCall holds the arguments of one FakeReview.ForManga call.
+type FakeReviewForMangaCall struct {
+	Ctx  context.Context
+	ID   jikan.ID
+	Page int
+}
+
+func (f *FakeReview) ForManga(ctx context.Context, id jikan.ID, page int) ([]jikan.Review, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.ForMangaCalls = append(f.ForMangaCalls, FakeReviewForMangaCall{ctx, id, page})
+	fn := f.ForMangaFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, page)
+	}
+	var r0 []jikan.Review
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeReviewForMangaAllCall holds the arguments of one FakeReview.ForMangaAll call.
+type FakeReviewForMangaAllCall struct {
+	Ctx      context.Context
+	ID       jikan.ID
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeReview) ForMangaAll(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Review, error] {
+	f.mu.Lock()
+	f.ForMangaAllCalls = append(f.ForMangaAllCalls, FakeReviewForMangaAllCall{ctx, id, pageOpts})
+	fn := f.ForMangaAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, pageOpts...)
+	}
+	return func(func(jikan.Review, error) bool) {}
+}
+
+// FakeRecommendation is an in-memory jikan.RecommendationAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakeRecommendation struct {
+	mu sync.Mutex
+
+	AnimeFunc     func(ctx context.Context, page int) ([]jikan.Recommendation, *jikan.Pagination, error)
+	AnimeCalls    []FakeRecommendationAnimeCall
+	AnimeAllFunc  func(ctx context.Context, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Recommendation, error]
+	AnimeAllCalls []FakeRecommendationAnimeAllCall
+	MangaFunc     func(ctx context.Context, page int) ([]jikan.Recommendation, *jikan.Pagination, error)
+	MangaCalls    []FakeRecommendationMangaCall
+	MangaAllFunc  func(ctx context.Context, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Recommendation, error]
+	MangaAllCalls []FakeRecommendationMangaAllCall
+}
+
+var _ jikan.RecommendationAPI = (*FakeRecommendation)(nil)
+
+// FakeRecommendationAnimeCall holds the arguments of one FakeRecommendation.Anime call.
+type FakeRecommendationAnimeCall struct {
+	Ctx  context.Context
+	Page int
+}
+
+func (f *FakeRecommendation) Anime(ctx context.Context, page int) ([]jikan.Recommendation, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.AnimeCalls = append(f.AnimeCalls, FakeRecommendationAnimeCall{ctx, page})
+	fn := f.AnimeFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, page)
+	}
+	var r0 []jikan.Recommendation
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeRecommendationAnimeAllCall holds the arguments of one FakeRecommendation.AnimeAll call.
+type FakeRecommendationAnimeAllCall struct {
+	Ctx      context.Context
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeRecommendation) AnimeAll(ctx context.Context, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Recommendation, error] {
+	f.mu.Lock()
+	f.AnimeAllCalls = append(f.AnimeAllCalls, FakeRecommendationAnimeAllCall{ctx, pageOpts})
+	fn := f.AnimeAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, pageOpts...)
+	}
+	return func(func(jikan.Recommendation, error) bool) {}
+}
+
+// FakeRecommendationMangaCall holds the arguments of one FakeRecommendation.Manga call.
+type FakeRecommendationMangaCall struct {
+	Ctx  context.Context
+	Page int
+}
+
+func (f *FakeRecommendation) Manga(ctx context.Context, page int) ([]jikan.Recommendation, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.MangaCalls = append(f.MangaCalls, FakeRecommendationMangaCall{ctx, page})
+	fn := f.MangaFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, page)
+	}
+	var r0 []jikan.Recommendation
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeRecommendationMangaAllCall holds the arguments of one FakeRecommendation.MangaAll call.
+type FakeRecommendationMangaAllCall struct {
+	Ctx      context.Context
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeRecommendation) MangaAll(ctx context.Context, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Recommendation, error] {
+	f.mu.Lock()
+	f.MangaAllCalls = append(f.MangaAllCalls, FakeRecommendationMangaAllCall{ctx, pageOpts})
+	fn := f.MangaAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, pageOpts...)
+	}
+	return func(func(jikan.Recommendation, error) bool) {}
+}
+
+// FakeWatch is an in-memory jikan.WatchAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakeWatch struct {
+	mu sync.Mutex
+
+	EpisodesFunc     func(ctx context.Context, page int) ([]jikan.EpisodePreview, *jikan.Pagination, error)
+	EpisodesCalls    []FakeWatchEpisodesCall
+	EpisodesAllFunc  func(ctx context.Context, pageOpts ...jikan.PageOption) iter.Seq2[jikan.EpisodePreview, error]
+	EpisodesAllCalls []FakeWatchEpisodesAllCall
+	PromosFunc       func(ctx context.Context, page int) ([]jikan.Promo, *jikan.Pagination, error)
+	PromosCalls      []FakeWatchPromosCall
+	PromosAllFunc    func(ctx context.Context, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Promo, error]
+	PromosAllCalls   []FakeWatchPromosAllCall
+}
+
+var _ jikan.WatchAPI = (*FakeWatch)(nil)
+
+// FakeWatchEpisodesCall holds the arguments of one FakeWatch.Episodes call.
+type FakeWatchEpisodesCall struct {
+	Ctx  context.Context
+	Page int
+}
+
+func (f *FakeWatch) Episodes(ctx context.Context, page int) ([]jikan.EpisodePreview, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.EpisodesCalls = append(f.EpisodesCalls, FakeWatchEpisodesCall{ctx, page})
+	fn := f.EpisodesFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, page)
+	}
+	var r0 []jikan.EpisodePreview
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeWatchEpisodesAllCall holds the arguments of one FakeWatch.EpisodesAll call.
+type FakeWatchEpisodesAllCall struct {
+	Ctx      context.Context
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeWatch) EpisodesAll(ctx context.Context, pageOpts ...jikan.PageOption) iter.Seq2[jikan.EpisodePreview, error] {
+	f.mu.Lock()
+	f.EpisodesAllCalls = append(f.EpisodesAllCalls, FakeWatchEpisodesAllCall{ctx, pageOpts})
+	fn := f.EpisodesAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, pageOpts...)
+	}
+	return func(func(jikan.EpisodePreview, error) bool) {}
+}
+
+// FakeWatchPromosCall holds the arguments of one FakeWatch.Promos call.
+type FakeWatchPromosCall struct {
+	Ctx  context.Context
+	Page int
+}
+
+func (f *FakeWatch) Promos(ctx context.Context, page int) ([]jikan.Promo, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.PromosCalls = append(f.PromosCalls, FakeWatchPromosCall{ctx, page})
+	fn := f.PromosFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, page)
+	}
+	var r0 []jikan.Promo
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeWatchPromosAllCall holds the arguments of one FakeWatch.PromosAll call.
+type FakeWatchPromosAllCall struct {
+	Ctx      context.Context
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeWatch) PromosAll(ctx context.Context, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Promo, error] {
+	f.mu.Lock()
+	f.PromosAllCalls = append(f.PromosAllCalls, FakeWatchPromosAllCall{ctx, pageOpts})
+	fn := f.PromosAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, pageOpts...)
+	}
+	return func(func(jikan.Promo, error) bool) {}
+}
+
+// FakeClub is an in-memory jikan.ClubAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakeClub struct {
+	mu sync.Mutex
+
+	ByIDFunc        func(ctx context.Context, id jikan.ID) (*jikan.Club, error)
+	ByIDCalls       []FakeClubByIDCall
+	ByIDRawFunc     func(ctx context.Context, id jikan.ID) (*jikan.Club, json.RawMessage, error)
+	ByIDRawCalls    []FakeClubByIDRawCall
+	ByIDsFunc       func(ctx context.Context, ids []jikan.ID, opts jikan.BatchOptions) *jikan.BatchResult[jikan.Club]
+	ByIDsCalls      []FakeClubByIDsCall
+	SearchFunc      func(ctx context.Context, query string, page int) ([]jikan.Club, *jikan.Pagination, error)
+	SearchCalls     []FakeClubSearchCall
+	SearchAllFunc   func(ctx context.Context, query string, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Club, error]
+	SearchAllCalls  []FakeClubSearchAllCall
+	MembersFunc     func(ctx context.Context, id jikan.ID, page int) ([]jikan.ClubMember, *jikan.Pagination, error)
+	MembersCalls    []FakeClubMembersCall
+	MembersAllFunc  func(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.ClubMember, error]
+	MembersAllCalls []FakeClubMembersAllCall
+	StaffFunc       func(ctx context.Context, id jikan.ID) ([]jikan.ClubStaff, error)
+	StaffCalls      []FakeClubStaffCall
+	RelationsFunc   func(ctx context.Context, id jikan.ID) (*jikan.ClubRelations, error)
+	RelationsCalls  []FakeClubRelationsCall
+}
+
+var _ jikan.ClubAPI = (*FakeClub)(nil)
+
+// FakeClubByIDCall holds the arguments of one FakeClub.ByID call.
+type FakeClubByIDCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeClub) ByID(ctx context.Context, id jikan.ID) (*jikan.Club, error) {
+	f.mu.Lock()
+	f.ByIDCalls = append(f.ByIDCalls, FakeClubByIDCall{ctx, id})
+	fn := f.ByIDFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.Club
+	var r1 error
+	return r0, r1
+}
+
+// FakeClubByIDRawCall holds the arguments of one FakeClub.ByIDRaw call.
+type FakeClubByIDRawCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeClub) ByIDRaw(ctx context.Context, id jikan.ID) (*jikan.Club, json.RawMessage, error) {
+	f.mu.Lock()
+	f.ByIDRawCalls = append(f.ByIDRawCalls, FakeClubByIDRawCall{ctx, id})
+	fn := f.ByIDRawFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.Club
+	var r1 json.RawMessage
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeClubByIDsCall holds the arguments of one FakeClub.ByIDs call.
+type FakeClubByIDsCall struct {
+	Ctx  context.Context
+	IDs  []jikan.ID
+	Opts jikan.BatchOptions
+}
+
+func (f *FakeClub) ByIDs(ctx context.Context, ids []jikan.ID, opts jikan.BatchOptions) *jikan.BatchResult[jikan.Club] {
+	f.mu.Lock()
+	f.ByIDsCalls = append(f.ByIDsCalls, FakeClubByIDsCall{ctx, ids, opts})
+	fn := f.ByIDsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, ids, opts)
+	}
+	return &jikan.BatchResult[jikan.Club]{Items: map[jikan.ID]*jikan.Club{}, Errors: map[jikan.ID]error{}}
+}
+
+// FakeClubSearchCall holds the arguments of one FakeClub.Search call.
+type FakeClubSearchCall struct {
+	Ctx   context.Context
+	Query string
+	Page  int
+}
+
+func (f *FakeClub) Search(ctx context.Context, query string, page int) ([]jikan.Club, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.SearchCalls = append(f.SearchCalls, FakeClubSearchCall{ctx, query, page})
+	fn := f.SearchFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, query, page)
+	}
+	var r0 []jikan.Club
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeClubSearchAllCall holds the arguments of one FakeClub.SearchAll call.
+type FakeClubSearchAllCall struct {
+	Ctx      context.Context
+	Query    string
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeClub) SearchAll(ctx context.Context, query string, pageOpts ...jikan.PageOption) iter.Seq2[jikan.Club, error] {
+	f.mu.Lock()
+	f.SearchAllCalls = append(f.SearchAllCalls, FakeClubSearchAllCall{ctx, query, pageOpts})
+	fn := f.SearchAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, query, pageOpts...)
+	}
+	return func(func(jikan.Club, error) bool) {}
+}
+
+// FakeClubMembersCall holds the arguments of one FakeClub.Members call.
+type FakeClubMembersCall struct {
+	Ctx  context.Context
+	ID   jikan.ID
+	Page int
+}
+
+func (f *FakeClub) Members(ctx context.Context, id jikan.ID, page int) ([]jikan.ClubMember, *jikan.Pagination, error) {
+	f.mu.Lock()
+	f.MembersCalls = append(f.MembersCalls, FakeClubMembersCall{ctx, id, page})
+	fn := f.MembersFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, page)
+	}
+	var r0 []jikan.ClubMember
+	var r1 *jikan.Pagination
+	var r2 error
+	return r0, r1, r2
+}
+
+// FakeClubMembersAllCall holds the arguments of one FakeClub.MembersAll call.
+type FakeClubMembersAllCall struct {
+	Ctx      context.Context
+	ID       jikan.ID
+	PageOpts []jikan.PageOption
+}
+
+func (f *FakeClub) MembersAll(ctx context.Context, id jikan.ID, pageOpts ...jikan.PageOption) iter.Seq2[jikan.ClubMember, error] {
+	f.mu.Lock()
+	f.MembersAllCalls = append(f.MembersAllCalls, FakeClubMembersAllCall{ctx, id, pageOpts})
+	fn := f.MembersAllFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id, pageOpts...)
+	}
+	return func(func(jikan.ClubMember, error) bool) {}
+}
+
+// FakeClubStaffCall holds the arguments of one FakeClub.Staff call.
+type FakeClubStaffCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeClub) Staff(ctx context.Context, id jikan.ID) ([]jikan.ClubStaff, error) {
+	f.mu.Lock()
+	f.StaffCalls = append(f.StaffCalls, FakeClubStaffCall{ctx, id})
+	fn := f.StaffFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 []jikan.ClubStaff
+	var r1 error
+	return r0, r1
+}
+
+// FakeClubRelationsCall holds the arguments of one FakeClub.Relations call.
+type FakeClubRelationsCall struct {
+	Ctx context.Context
+	ID  jikan.ID
+}
+
+func (f *FakeClub) Relations(ctx context.Context, id jikan.ID) (*jikan.ClubRelations, error) {
+	f.mu.Lock()
+	f.RelationsCalls = append(f.RelationsCalls, FakeClubRelationsCall{ctx, id})
+	fn := f.RelationsFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	var r0 *jikan.ClubRelations
+	var r1 error
+	return r0, r1
+}
+
+// FakeRandom is an in-memory jikan.RandomAPI. Each method records its arguments in
+// the matching Calls slice and returns what the matching Func field
+// returns, or zero values when it is nil.
+type FakeRandom struct {
+	mu sync.Mutex
+
+	AnimeFunc      func(ctx context.Context) (*jikan.Anime, error)
+	AnimeCalls     []FakeRandomAnimeCall
+	MangaFunc      func(ctx context.Context) (*jikan.Manga, error)
+	MangaCalls     []FakeRandomMangaCall
+	CharacterFunc  func(ctx context.Context) (*jikan.Character, error)
+	CharacterCalls []FakeRandomCharacterCall
+	PersonFunc     func(ctx context.Context) (*jikan.Person, error)
+	PersonCalls    []FakeRandomPersonCall
+}
+
+var _ jikan.RandomAPI = (*FakeRandom)(nil)
+
+// FakeRandomAnimeCall holds the arguments of one FakeRandom.Anime call.
+type FakeRandomAnimeCall struct {
+	Ctx context.Context
+}
+
+func (f *FakeRandom) Anime(ctx context.Context) (*jikan.Anime, error) {
+	f.mu.Lock()
+	f.AnimeCalls = append(f.AnimeCalls, FakeRandomAnimeCall{ctx})
+	fn := f.AnimeFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx)
+	}
+	var r0 *jikan.Anime
+	var r1 error
+	return r0, r1
+}
+
+// FakeRandomMangaCall holds the arguments of one FakeRandom.Manga call.
+type FakeRandomMangaCall struct {
+	Ctx context.Context
+}
+
+func (f *FakeRandom) Manga(ctx context.Context) (*jikan.Manga, error) {
+	f.mu.Lock()
+	f.MangaCalls = append(f.MangaCalls, FakeRandomMangaCall{ctx})
+	fn := f.MangaFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx)
+	}
+	var r0 *jikan.Manga
+	var r1 error
+	return r0, r1
+}
+
+// FakeRandomCharacterCall holds the arguments of one FakeRandom.Character call.
+type FakeRandomCharacterCall struct {
+	Ctx context.Context
+}
+
+func (f *FakeRandom) Character(ctx context.Context) (*jikan.Character, error) {
+	f.mu.Lock()
+	f.CharacterCalls = append(f.CharacterCalls, FakeRandomCharacterCall{ctx})
+	fn := f.CharacterFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx)
+	}
+	var r0 *jikan.Character
+	var r1 error
+	return r0, r1
+}
+
+// FakeRandomPersonCall holds the arguments of one FakeRandom.Person call.
+type FakeRandomPersonCall struct {
+	Ctx context.Context
+}
+
+func (f *FakeRandom) Person(ctx context.Context) (*jikan.Person, error) {
+	f.mu.Lock()
+	f.PersonCalls = append(f.PersonCalls, FakeRandomPersonCall{ctx})
+	fn := f.PersonFunc
+	f.mu.Unlock()
+	if fn != nil {
+		return fn(ctx)
+	}
+	var r0 *jikan.Person
+	var r1 error
+	return r0, r1
+}
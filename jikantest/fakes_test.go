@@ -0,0 +1,46 @@
+package jikantest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Sethispr/jikanGo"
+)
+
+func TestFakeDefaults(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeAPI()
+
+	res := fake.Anime.ByIDs(ctx, []jikan.ID{1, 2}, jikan.BatchOptions{})
+	if res == nil {
+		t.Fatal("ByIDs returned nil")
+	}
+	if err := res.Err(); err != nil {
+		t.Errorf("Err() = %v", err)
+	}
+	if len(res.Items) != 0 {
+		t.Errorf("Items = %v, want empty", res.Items)
+	}
+
+	for range fake.Season.NowAll(ctx, jikan.SeasonOptions{}) {
+		t.Error("NowAll yielded from an unprogrammed fake")
+	}
+	if n := len(fake.Anime.ByIDsCalls); n != 1 {
+		t.Errorf("recorded %d ByIDs calls, want 1", n)
+	}
+}
+
+func TestFakeProgrammed(t *testing.T) {
+	fake := NewFakeAPI()
+	fake.Anime.ByIDFunc = func(ctx context.Context, id jikan.ID) (*jikan.Anime, error) {
+		return &jikan.Anime{MalID: id, Title: "Cowboy Bebop"}, nil
+	}
+	var api jikan.API = fake
+	a, err := api.AnimeAPI().ByID(context.Background(), 1)
+	if err != nil || a.Title != "Cowboy Bebop" {
+		t.Fatalf("ByID = %v, %v", a, err)
+	}
+	if got := fake.Anime.ByIDCalls; len(got) != 1 || got[0].ID != 1 {
+		t.Errorf("ByIDCalls = %+v", got)
+	}
+}
@@ -0,0 +1,261 @@
+package jikantest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrNotRecorded is returned by a replaying Recorder for requests that
+// have no interaction in the cassette.
+var ErrNotRecorded = errors.New("jikantest: request not recorded in cassette")
+
+// Mode selects how a Recorder treats the network and its cassette.
+type Mode int
+
+const (
+	// ModeReplay serves only recorded interactions and fails with
+	// ErrNotRecorded on anything else. It never touches the network.
+	ModeReplay Mode = iota
+	// ModeRecord replays recorded interactions and records the rest.
+	ModeRecord
+	// ModeUpdate sends every request upstream and replaces the recorded
+	// interactions for each key it sees.
+	ModeUpdate
+)
+
+// Interaction is one recorded request/response pair.
+type Interaction struct {
+	Key      string           `json:"key"`
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+}
+
+type RecordedResponse struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body"`
+}
+
+type cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper that records Jikan responses to a
+// cassette file and replays them. Use it with jikan.WithHTTPClient:
+//
+//	rec, err := jikantest.NewRecorder("testdata/anime.json", jikantest.ModeReplay)
+//	client := jikan.New(jikan.WithHTTPClient(rec.Client()))
+//	defer rec.Save()
+//
+// Interactions are keyed by method, path and query with its parameters
+// sorted, so option order does not matter. Repeated requests for a key
+// replay its interactions in order, then keep returning the last one.
+type Recorder struct {
+	// Transport sends requests upstream. Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+	// Redact, if set, is called on every new interaction before it is
+	// stored, to strip credentials or other data from the cassette.
+	Redact func(*Interaction)
+
+	path string
+	mode Mode
+
+	mu      sync.Mutex
+	byKey   map[string][]*Interaction
+	order   []string
+	served  map[string]int
+	updated map[string]bool
+	dirty   bool
+}
+
+// NewRecorder loads the cassette at path. A missing file is an error in
+// ModeReplay and an empty cassette otherwise.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{
+		path:    path,
+		mode:    mode,
+		byKey:   make(map[string][]*Interaction),
+		served:  make(map[string]int),
+		updated: make(map[string]bool),
+	}
+	b, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist) && mode != ModeReplay:
+		return r, nil
+	case err != nil:
+		return nil, fmt.Errorf("jikantest: loading cassette: %w", err)
+	}
+	var c cassette
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("jikantest: decoding cassette %s: %w", path, err)
+	}
+	for _, in := range c.Interactions {
+		r.add(in)
+	}
+	return r, nil
+}
+
+// Client returns an http.Client using r as its transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RequestKey returns the cassette key for req.
+func RequestKey(req *http.Request) string {
+	q := req.URL.Query()
+	for _, v := range q {
+		sort.Strings(v)
+	}
+	key := req.Method + " " + req.URL.Path
+	if enc := q.Encode(); enc != "" {
+		key += "?" + enc
+	}
+	return key
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := RequestKey(req)
+
+	r.mu.Lock()
+	in := r.next(key)
+	r.mu.Unlock()
+	if in != nil {
+		return in.Response.toHTTP(req), nil
+	}
+	if r.mode == ModeReplay {
+		return nil, fmt.Errorf("%w: %s", ErrNotRecorded, key)
+	}
+
+	t := r.Transport
+	if t == nil {
+		t = http.DefaultTransport
+	}
+	resp, err := t.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	in = &Interaction{
+		Key:      key,
+		Request:  RecordedRequest{Method: req.Method, URL: req.URL.String(), Header: req.Header.Clone()},
+		Response: RecordedResponse{Status: resp.StatusCode, Header: resp.Header.Clone(), Body: string(body)},
+	}
+	if r.Redact != nil {
+		r.Redact(in)
+	}
+
+	r.mu.Lock()
+	if r.mode == ModeUpdate && !r.updated[key] {
+		delete(r.byKey, key)
+		r.updated[key] = true
+	}
+	r.add(in)
+	r.served[key] = len(r.byKey[key])
+	r.dirty = true
+	r.mu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// next returns the recorded interaction to serve for key, or nil if the
+// request has to go upstream. It must be called with r.mu held.
+func (r *Recorder) next(key string) *Interaction {
+	if r.mode == ModeUpdate {
+		return nil
+	}
+	list := r.byKey[key]
+	if len(list) == 0 {
+		return nil
+	}
+	i := min(r.served[key], len(list)-1)
+	r.served[key]++
+	return list[i]
+}
+
+func (r *Recorder) add(in *Interaction) {
+	if !slices.Contains(r.order, in.Key) {
+		r.order = append(r.order, in.Key)
+	}
+	r.byKey[in.Key] = append(r.byKey[in.Key], in)
+}
+
+// Save writes the cassette if anything was recorded. Interactions keep the
+// order in which their keys were first seen.
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.dirty {
+		return nil
+	}
+	var c cassette
+	for _, k := range r.order {
+		c.Interactions = append(c.Interactions, r.byKey[k]...)
+	}
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	tmp := r.path + ".tmp"
+	if err := os.WriteFile(tmp, append(b, '\n'), 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, r.path); err != nil {
+		return err
+	}
+	r.dirty = false
+	return nil
+}
+
+func (rr RecordedResponse) toHTTP(req *http.Request) *http.Response {
+	h := rr.Header.Clone()
+	if h == nil {
+		h = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rr.Status, http.StatusText(rr.Status)),
+		StatusCode:    rr.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        h,
+		Body:          io.NopCloser(strings.NewReader(rr.Body)),
+		ContentLength: int64(len(rr.Body)),
+		Request:       req,
+	}
+}
+
+// RedactHeaders returns a Redact hook that removes the named headers from
+// both the request and the response.
+func RedactHeaders(names ...string) func(*Interaction) {
+	return func(in *Interaction) {
+		for _, n := range names {
+			in.Request.Header.Del(n)
+			in.Response.Header.Del(n)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package jikantest
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Sethispr/jikanGo"
+)
+
+func TestRecorderRecordReplay(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "cassettes", "anime.json")
+	srv := NewServer()
+	srv.AddAnime(jikan.Anime{MalID: 1, Title: "Cowboy Bebop"})
+	base := jikan.WithBaseURL(srv.URL + "/v4")
+
+	rec, err := NewRecorder(path, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec.Redact = RedactHeaders("User-Agent")
+	c := jikan.New(jikan.WithHTTPClient(rec.Client()), base)
+	if _, err := c.Anime.ByID(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Anime.ByID(ctx, 404); !errors.Is(err, jikan.ErrNotFound) {
+		t.Fatalf("recording a 404: got %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "User-Agent") {
+		t.Error("cassette keeps the redacted User-Agent header")
+	}
+
+	// Replay with the upstream gone: only the cassette can answer.
+	srv.Close()
+	rec, err = NewRecorder(path, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c = jikan.New(jikan.WithHTTPClient(rec.Client()), base)
+	a, err := c.Anime.ByID(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Title != "Cowboy Bebop" {
+		t.Errorf("replayed title %q", a.Title)
+	}
+	if _, err := c.Anime.ByID(ctx, 404); !errors.Is(err, jikan.ErrNotFound) {
+		t.Errorf("replaying a 404: got %v", err)
+	}
+	if _, err := c.Anime.ByID(ctx, 2); !errors.Is(err, ErrNotRecorded) {
+		t.Errorf("unrecorded request: got %v, want ErrNotRecorded", err)
+	}
+}
+
+func TestRecorderMissingCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing.json")
+	if _, err := NewRecorder(path, ModeReplay); err == nil {
+		t.Error("replaying a missing cassette succeeded")
+	}
+	rec, err := NewRecorder(path, ModeRecord)
+	if err != nil {
+		t.Fatalf("recording to a new cassette: %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Save with nothing recorded wrote %s", path)
+	}
+}
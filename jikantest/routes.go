@@ -0,0 +1,336 @@
+package jikantest
+
+import (
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Sethispr/jikanGo"
+)
+
+// AddAnime seeds anime for /anime/{id}, /anime/{id}/full, /anime search,
+// /top/anime, /random/anime and the season routes, which select entries by
+// Airing, Status, Year and Season.
+func (s *Server) AddAnime(a ...jikan.Anime) {
+	s.mu.Lock()
+	s.anime = append(s.anime, a...)
+	s.mu.Unlock()
+}
+
+// AddManga seeds manga for /manga/{id}, /manga/{id}/full, /manga search,
+// /top/manga and /random/manga.
+func (s *Server) AddManga(m ...jikan.Manga) {
+	s.mu.Lock()
+	s.manga = append(s.manga, m...)
+	s.mu.Unlock()
+}
+
+// AddCharacter seeds characters for /characters/{id}, /characters/{id}/full,
+// /characters search, /top/characters and /random/characters.
+func (s *Server) AddCharacter(c ...jikan.Character) {
+	s.mu.Lock()
+	s.characters = append(s.characters, c...)
+	s.mu.Unlock()
+}
+
+// AddPerson seeds people for /people/{id}, /top/people and /random/people.
+func (s *Server) AddPerson(p ...jikan.Person) {
+	s.mu.Lock()
+	s.people = append(s.people, p...)
+	s.mu.Unlock()
+}
+
+// AddClub seeds clubs for /clubs/{id} and /clubs search.
+func (s *Server) AddClub(c ...jikan.Club) {
+	s.mu.Lock()
+	s.clubs = append(s.clubs, c...)
+	s.mu.Unlock()
+}
+
+// AddProducer seeds producers for /producers/{id}, /producers/{id}/full
+// and /producers search.
+func (s *Server) AddProducer(p ...jikan.Producer) {
+	s.mu.Lock()
+	s.producers = append(s.producers, p...)
+	s.mu.Unlock()
+}
+
+// AddMagazine seeds magazines for the /magazines list, kept in ID order
+// as Jikan sends it. Jikan has no /magazines/{id} route.
+func (s *Server) AddMagazine(m ...jikan.Magazine) {
+	s.mu.Lock()
+	s.magazines = append(s.magazines, m...)
+	slices.SortFunc(s.magazines, func(a, b jikan.Magazine) int { return int(a.MalID - b.MalID) })
+	s.mu.Unlock()
+}
+
+// AddUser seeds users for /users/{username}, /users/{username}/full,
+// /users/{username}/statistics, /users/userbyid/{id} and /users search.
+func (s *Server) AddUser(u ...jikan.UserFull) {
+	s.mu.Lock()
+	s.users = append(s.users, u...)
+	s.mu.Unlock()
+}
+
+// AddGenre seeds /genres/anime or /genres/manga; kind is "anime" or "manga".
+func (s *Server) AddGenre(kind string, g ...jikan.Genre) {
+	s.mu.Lock()
+	s.genres[kind] = append(s.genres[kind], g...)
+	s.mu.Unlock()
+}
+
+// AddReview seeds /reviews/recent and /top/reviews.
+func (s *Server) AddReview(r ...jikan.Review) {
+	s.mu.Lock()
+	s.reviews = append(s.reviews, r...)
+	s.mu.Unlock()
+}
+
+// AddRecommendation seeds /recommendations/anime or /recommendations/manga;
+// kind is "anime" or "manga".
+func (s *Server) AddRecommendation(kind string, r ...jikan.Recommendation) {
+	s.mu.Lock()
+	s.recs[kind] = append(s.recs[kind], r...)
+	s.mu.Unlock()
+}
+
+// AddWatchEpisode seeds /watch/episodes.
+func (s *Server) AddWatchEpisode(e ...jikan.EpisodePreview) {
+	s.mu.Lock()
+	s.episodes = append(s.episodes, e...)
+	s.mu.Unlock()
+}
+
+// AddPromo seeds /watch/promos.
+func (s *Server) AddPromo(p ...jikan.Promo) {
+	s.mu.Lock()
+	s.promos = append(s.promos, p...)
+	s.mu.Unlock()
+}
+
+// route resolves p to either a single payload or a list to paginate.
+// Routes set explicitly with Set or SetPaged win over seeded entities.
+// It must be called with s.mu held.
+func (s *Server) route(p string, q url.Values) (data any, items []json.RawMessage, ok bool) {
+	if b, ok := s.routes[p]; ok {
+		return b, nil, true
+	}
+	if l, ok := s.paged[p]; ok {
+		return nil, l, true
+	}
+
+	seg := strings.Split(strings.Trim(p, "/"), "/")
+	query := strings.ToLower(q.Get("q"))
+	switch {
+	case len(seg) == 1:
+		switch seg[0] {
+		case "anime":
+			return list(s.anime, query, func(a jikan.Anime) string { return a.Title })
+		case "manga":
+			return list(s.manga, query, func(m jikan.Manga) string { return m.Title })
+		case "characters":
+			return list(s.characters, query, func(c jikan.Character) string { return c.Name })
+		case "people":
+			return list(s.people, query, func(p jikan.Person) string { return p.Name })
+		case "clubs":
+			return list(s.clubs, query, func(c jikan.Club) string { return c.Name })
+		case "producers":
+			return list(s.producers, query, func(p jikan.Producer) string { return p.Name() })
+		case "magazines":
+			return list(s.magazines, query, func(m jikan.Magazine) string { return m.Name })
+		case "users":
+			refs := make([]jikan.UserSearchResult, 0, len(s.users))
+			for _, u := range s.users {
+				refs = append(refs, jikan.UserSearchResult{URL: u.URL, Username: u.Username, LastOnline: u.LastOnline})
+			}
+			return list(refs, query, func(u jikan.UserSearchResult) string { return u.Username })
+		case "seasons":
+			return s.seasonList()
+		}
+	case seg[0] == "users":
+		return s.user(seg[1:])
+	case len(seg) == 2 && seg[0] == "top":
+		switch seg[1] {
+		case "anime":
+			return list(s.anime, "", nil)
+		case "manga":
+			return list(s.manga, "", nil)
+		case "characters":
+			return list(s.characters, "", nil)
+		case "people":
+			return list(s.people, "", nil)
+		case "reviews":
+			return list(s.reviews, "", nil)
+		}
+	case len(seg) == 2 && seg[0] == "random":
+		switch seg[1] {
+		case "anime":
+			return pick(s, "anime", s.anime)
+		case "manga":
+			return pick(s, "manga", s.manga)
+		case "characters":
+			return pick(s, "characters", s.characters)
+		case "people":
+			return pick(s, "people", s.people)
+		}
+	case len(seg) == 2 && seg[0] == "genres":
+		if g, ok := s.genres[seg[1]]; ok {
+			// Jikan sends genre lists whole, without pagination.
+			return g, nil, true
+		}
+	case len(seg) == 2 && seg[0] == "recommendations":
+		if r, ok := s.recs[seg[1]]; ok {
+			return list(r, "", nil)
+		}
+	case p == "/reviews/recent":
+		return list(s.reviews, "", nil)
+	case p == "/watch/episodes":
+		return list(s.episodes, "", nil)
+	case p == "/watch/promos":
+		return list(s.promos, "", nil)
+	case seg[0] == "seasons":
+		return s.season(seg[1:])
+	case len(seg) == 2 || len(seg) == 3 && seg[2] == "full":
+		id, err := strconv.Atoi(seg[1])
+		if err != nil {
+			return nil, nil, false
+		}
+		return s.entity(seg[0], jikan.ID(id))
+	}
+	return nil, nil, false
+}
+
+func (s *Server) entity(kind string, id jikan.ID) (any, []json.RawMessage, bool) {
+	switch kind {
+	case "anime":
+		return find(s.anime, func(a jikan.Anime) bool { return a.MalID == id })
+	case "manga":
+		return find(s.manga, func(m jikan.Manga) bool { return m.MalID == id })
+	case "characters":
+		return find(s.characters, func(c jikan.Character) bool { return c.MalID == id })
+	case "people":
+		return find(s.people, func(p jikan.Person) bool { return p.MalID == id })
+	case "clubs":
+		return find(s.clubs, func(c jikan.Club) bool { return c.MalID == id })
+	case "producers":
+		return find(s.producers, func(p jikan.Producer) bool { return p.MalID == id })
+	}
+	return nil, nil, false
+}
+
+func (s *Server) user(seg []string) (any, []json.RawMessage, bool) {
+	if len(seg) == 2 && seg[0] == "userbyid" {
+		id, err := strconv.Atoi(seg[1])
+		if err != nil {
+			return nil, nil, false
+		}
+		for _, u := range s.users {
+			if u.MalID == jikan.ID(id) {
+				return jikan.UserRef{URL: u.URL, Username: u.Username}, nil, true
+			}
+		}
+		return nil, nil, false
+	}
+	i := slices.IndexFunc(s.users, func(u jikan.UserFull) bool {
+		return strings.EqualFold(u.Username, seg[0])
+	})
+	if i < 0 || len(seg) > 2 {
+		return nil, nil, false
+	}
+	u := s.users[i]
+	if len(seg) == 1 {
+		return u.User, nil, true
+	}
+	switch seg[1] {
+	case "full":
+		return u, nil, true
+	case "statistics":
+		return u.Statistics, nil, true
+	case "external":
+		return u.External, nil, true
+	}
+	return nil, nil, false
+}
+
+func (s *Server) season(seg []string) (any, []json.RawMessage, bool) {
+	var match func(jikan.Anime) bool
+	switch {
+	case len(seg) == 1 && seg[0] == "now":
+		match = func(a jikan.Anime) bool { return a.Airing }
+	case len(seg) == 1 && seg[0] == "upcoming":
+		match = func(a jikan.Anime) bool { return a.Status == jikan.AnimeStatusUpcoming }
+	case len(seg) == 2:
+		year, err := strconv.Atoi(seg[0])
+		if err != nil {
+			return nil, nil, false
+		}
+		season := jikan.Season(seg[1])
+		match = func(a jikan.Anime) bool { return a.Year.ValueOr(0) == year && a.Season == season }
+	default:
+		return nil, nil, false
+	}
+	var out []jikan.Anime
+	for _, a := range s.anime {
+		if match(a) {
+			out = append(out, a)
+		}
+	}
+	return list(out, "", nil)
+}
+
+// seasonList derives /seasons from the seeded anime, newest year first.
+func (s *Server) seasonList() (any, []json.RawMessage, bool) {
+	var out []jikan.SeasonArchive
+	for _, a := range s.anime {
+		year, ok := a.Year.Get()
+		if !ok || a.Season == "" {
+			continue
+		}
+		i := slices.IndexFunc(out, func(sa jikan.SeasonArchive) bool { return sa.Year == year })
+		if i < 0 {
+			out = append(out, jikan.SeasonArchive{Year: year})
+			i = len(out) - 1
+		}
+		if !slices.Contains(out[i].Seasons, a.Season) {
+			out[i].Seasons = append(out[i].Seasons, a.Season)
+		}
+	}
+	slices.SortFunc(out, func(a, b jikan.SeasonArchive) int { return b.Year - a.Year })
+	return list(out, "", nil)
+}
+
+// list encodes items for pagination, keeping those whose name contains
+// query when both are given.
+func list[T any](items []T, query string, name func(T) string) (any, []json.RawMessage, bool) {
+	out := make([]json.RawMessage, 0, len(items))
+	for _, v := range items {
+		if query != "" && name != nil && !strings.Contains(strings.ToLower(name(v)), query) {
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, nil, false
+		}
+		out = append(out, b)
+	}
+	return nil, out, true
+}
+
+func find[T any](items []T, match func(T) bool) (any, []json.RawMessage, bool) {
+	if i := slices.IndexFunc(items, match); i >= 0 {
+		return items[i], nil, true
+	}
+	return nil, nil, false
+}
+
+// pick cycles through items so repeated /random calls are deterministic.
+func pick[T any](s *Server, kind string, items []T) (any, []json.RawMessage, bool) {
+	if len(items) == 0 {
+		return nil, nil, false
+	}
+	i := s.random[kind] % len(items)
+	s.random[kind]++
+	return items[i], nil, true
+}
@@ -0,0 +1,108 @@
+package jikantest
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/Sethispr/jikanGo"
+)
+
+type data[T any] struct {
+	Data T `json:"data"`
+}
+
+type paged[T any] struct {
+	Data       T                `json:"data"`
+	Pagination jikan.Pagination `json:"pagination"`
+}
+
+func one[T any]() any  { return new(data[T]) }
+func page[T any]() any { return new(paged[T]) }
+
+type route struct {
+	method string
+	path   string
+	model  func() any
+}
+
+// models maps each endpoint template to the envelopes the services decode
+// its responses into. An endpoint can have several, e.g. /anime/{id}/reviews
+// is read by both AnimeService and ReviewService.
+var models = func() map[string][]func() any {
+	m := map[string][]func() any{}
+	for _, r := range routes {
+		t := jikan.EndpointTemplate(r.path)
+		m[t] = append(m[t], r.model)
+	}
+	return m
+}()
+
+// Templates returns the endpoint templates the services request, sorted.
+func Templates() []string {
+	return slices.Sorted(maps.Keys(models))
+}
+
+// ValidateSample checks a saved response body for the API path it came
+// from against every model the services decode that endpoint into, and
+// returns the differences found against any of them.
+func ValidateSample(path string, body []byte) (jikan.DriftReport, error) {
+	tmpl := jikan.EndpointTemplate(path)
+	ms, ok := models[tmpl]
+	if !ok {
+		return jikan.DriftReport{}, fmt.Errorf("jikantest: no model registered for %s", tmpl)
+	}
+	var unknown, missing []string
+	for _, model := range ms {
+		r, err := jikan.CheckDrift(tmpl, body, model())
+		if err != nil {
+			return jikan.DriftReport{}, err
+		}
+		unknown = append(unknown, r.Unknown...)
+		missing = append(missing, r.Missing...)
+	}
+	slices.Sort(unknown)
+	slices.Sort(missing)
+	return jikan.DriftReport{
+		Endpoint: tmpl,
+		Unknown:  slices.Compact(unknown),
+		Missing:  slices.Compact(missing),
+		Count:    1,
+	}, nil
+}
+
+// ValidateCassette checks every successful response in a cassette
+// recorded by Recorder and returns the merged reports that found drift.
+func ValidateCassette(path string) ([]jikan.DriftReport, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c cassette
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("jikantest: decoding cassette %s: %w", path, err)
+	}
+	col := jikan.NewDriftCollector()
+	for _, in := range c.Interactions {
+		if in.Response.Status != http.StatusOK {
+			continue
+		}
+		u, err := url.Parse(in.Request.URL)
+		if err != nil {
+			return nil, err
+		}
+		r, err := ValidateSample(strings.TrimPrefix(u.Path, "/v4"), []byte(in.Response.Body))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", in.Key, err)
+		}
+		if !r.Empty() {
+			col.Record(r)
+		}
+	}
+	return col.Reports(), nil
+}
@@ -0,0 +1,132 @@
+package jikantest
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Sethispr/jikanGo"
+)
+
+var (
+	update = flag.Bool("update", false, "rewrite testdata/drift.golden.json from the samples")
+	record = flag.Bool("record", false, "re-record testdata/samples from the live Jikan API")
+)
+
+const golden = "testdata/drift.golden.json"
+
+func sampleFile(tmpl string) string {
+	name := strings.NewReplacer("/", "_", "{", "", "}", "").Replace(strings.TrimPrefix(tmpl, "/"))
+	return filepath.Join("testdata", "samples", name+".json")
+}
+
+// TestSamples checks every endpoint the services request against its
+// sample and compares the drift found with the golden file, so a model
+// change that breaks decoding of a real payload shows up as a diff.
+func TestSamples(t *testing.T) {
+	if *record {
+		recordSamples(t)
+	}
+	got := map[string]jikan.DriftReport{}
+	files := map[string]bool{}
+	for _, tmpl := range Templates() {
+		file := sampleFile(tmpl)
+		files[filepath.Base(file)] = true
+		body, err := os.ReadFile(file)
+		if err != nil {
+			t.Errorf("%s: no sample: %v", tmpl, err)
+			continue
+		}
+		r, err := ValidateSample(tmpl, body)
+		if err != nil {
+			t.Errorf("%s: %v", tmpl, err)
+			continue
+		}
+		if !r.Empty() {
+			got[tmpl] = r
+		}
+	}
+	entries, err := os.ReadDir(filepath.Join("testdata", "samples"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		if !files[e.Name()] {
+			t.Errorf("sample %s matches no endpoint the services request", e.Name())
+		}
+	}
+
+	if *update {
+		b, err := json.MarshalIndent(got, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, append(b, '\n'), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	b, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want map[string]jikan.DriftReport
+	if err := json.Unmarshal(b, &want); err != nil {
+		t.Fatal(err)
+	}
+	for tmpl, r := range got {
+		if !reflect.DeepEqual(r, want[tmpl]) {
+			t.Errorf("%s: drift is %+v, golden has %+v", tmpl, r, want[tmpl])
+		}
+	}
+	for tmpl, r := range want {
+		if _, ok := got[tmpl]; !ok {
+			t.Errorf("%s: no drift, golden has %+v; run with -update", tmpl, r)
+		}
+	}
+}
+
+// recordSamples replaces the samples with live responses, fetching one
+// sample path per endpoint through a Recorder at Jikan's public rate
+// limit. The cassette is written to testdata/recorded.json so the
+// request behind each sample can be checked; Jikan's error responses are
+// kept there but leave the sample alone.
+func recordSamples(t *testing.T) {
+	rec, err := NewRecorder(filepath.Join("testdata", "recorded.json"), ModeUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec.Redact = RedactHeaders("User-Agent", "Set-Cookie")
+	c := jikan.New(jikan.WithHTTPClient(rec.Client()), jikan.WithRateLimit(1))
+	defer func() {
+		if err := rec.Save(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	paths := map[string]string{}
+	for _, r := range routes {
+		tmpl := jikan.EndpointTemplate(r.path)
+		if _, ok := paths[tmpl]; !ok {
+			paths[tmpl] = r.path
+		}
+	}
+	for _, tmpl := range slices.Sorted(maps.Keys(paths)) {
+		var body json.RawMessage
+		if err := c.Do(context.Background(), http.MethodGet, paths[tmpl], nil, &body); err != nil {
+			t.Errorf("%s: %v", paths[tmpl], err)
+			continue
+		}
+		if err := os.WriteFile(sampleFile(tmpl), body, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
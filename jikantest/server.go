@@ -0,0 +1,276 @@
+// Package jikantest provides an in-process fake of the Jikan v4 API for
+// testing code that uses jikan.Client.
+//
+// A Server is seeded with fixtures through the Add* methods, which fill
+// the entity, search, top, season and random routes, and through Set and
+// SetPaged for any other route. Faults such as error statuses, latency
+// and malformed bodies can be injected per path.
+package jikantest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Sethispr/jikanGo"
+)
+
+const perPage = 25
+
+// Fault changes how the server answers requests whose path matches Path,
+// a path.Match pattern without the /v4 prefix such as "/anime/*". An empty
+// Path matches every request. Times limits how many requests are affected;
+// zero means all of them.
+type Fault struct {
+	Path   string
+	Status int
+	Body   string
+	Delay  time.Duration
+	Times  int
+}
+
+// Server is a fake Jikan API. The embedded httptest.Server is exposed for
+// its URL and Close; use Client to get a jikan.Client talking to it.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	latency  time.Duration
+	faults   []*Fault
+	requests []string
+	routes   map[string]json.RawMessage
+	paged    map[string][]json.RawMessage
+	random   map[string]int
+
+	anime      []jikan.Anime
+	manga      []jikan.Manga
+	characters []jikan.Character
+	people     []jikan.Person
+	clubs      []jikan.Club
+	producers  []jikan.Producer
+	magazines  []jikan.Magazine
+	users      []jikan.UserFull
+	genres     map[string][]jikan.Genre
+	reviews    []jikan.Review
+	recs       map[string][]jikan.Recommendation
+	episodes   []jikan.EpisodePreview
+	promos     []jikan.Promo
+}
+
+// NewServer starts a fake Jikan server with no fixtures. Call Close when
+// done, or use NewClient to have the test do it.
+func NewServer() *Server {
+	s := &Server{
+		routes: make(map[string]json.RawMessage),
+		paged:  make(map[string][]json.RawMessage),
+		random: make(map[string]int),
+		genres: make(map[string][]jikan.Genre),
+		recs:   make(map[string][]jikan.Recommendation),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// NewClient starts a Server that is closed when tb finishes and returns a
+// client pointed at it along with the server for seeding.
+func NewClient(tb testing.TB, opts ...jikan.Option) (*jikan.Client, *Server) {
+	tb.Helper()
+	s := NewServer()
+	tb.Cleanup(s.Close)
+	return s.Client(opts...), s
+}
+
+// Client returns a jikan.Client whose requests are sent to s. Options are
+// applied after the base URL is set, so WithTimeout and friends work as
+// usual.
+func (s *Server) Client(opts ...jikan.Option) *jikan.Client {
+	base := []jikan.Option{
+		jikan.WithHTTPClient(&http.Client{Timeout: 30 * time.Second}),
+		jikan.WithBaseURL(s.URL + "/v4"),
+	}
+	return jikan.New(append(base, opts...)...)
+}
+
+// SetLatency delays every response by d.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	s.latency = d
+	s.mu.Unlock()
+}
+
+// Inject adds a fault. Faults are checked in the order they were added.
+func (s *Server) Inject(f Fault) {
+	s.mu.Lock()
+	s.faults = append(s.faults, &f)
+	s.mu.Unlock()
+}
+
+// NotFound makes matching requests return 404.
+func (s *Server) NotFound(pattern string) {
+	s.Inject(Fault{Path: pattern, Status: http.StatusNotFound})
+}
+
+// RateLimit makes the next times matching requests return 429.
+func (s *Server) RateLimit(pattern string, times int) {
+	s.Inject(Fault{Path: pattern, Status: http.StatusTooManyRequests, Times: times})
+}
+
+// ServerError makes the next times matching requests return status, which
+// should be a 5xx code.
+func (s *Server) ServerError(pattern string, status, times int) {
+	s.Inject(Fault{Path: pattern, Status: status, Times: times})
+}
+
+// Malformed makes the next times matching requests return a truncated
+// JSON body with status 200.
+func (s *Server) Malformed(pattern string, times int) {
+	s.Inject(Fault{Path: pattern, Body: `{"data": {"mal_id": 1,`, Times: times})
+}
+
+// Reset drops all faults and latency but keeps fixtures.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	s.faults = nil
+	s.latency = 0
+	s.mu.Unlock()
+}
+
+// Requests returns the path and query of every request served so far.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// Set serves data as the "data" member of route, for example
+// Set("/anime/1/themes", jikan.AnimeThemes{...}).
+func (s *Server) Set(route string, data any) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		panic(fmt.Sprintf("jikantest: encoding fixture for %s: %v", route, err))
+	}
+	s.mu.Lock()
+	s.routes[route] = b
+	s.mu.Unlock()
+}
+
+// SetPaged serves items as a paginated list on route.
+func SetPaged[T any](s *Server, route string, items []T) {
+	raw := make([]json.RawMessage, len(items))
+	for i, v := range items {
+		b, err := json.Marshal(v)
+		if err != nil {
+			panic(fmt.Sprintf("jikantest: encoding fixture for %s: %v", route, err))
+		}
+		raw[i] = b
+	}
+	s.mu.Lock()
+	s.paged[route] = raw
+	s.mu.Unlock()
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	p := strings.TrimPrefix(r.URL.Path, "/v4")
+	s.mu.Lock()
+	s.requests = append(s.requests, r.URL.RequestURI())
+	delay := s.latency
+	var fault *Fault
+	for _, f := range s.faults {
+		if f.Times < 0 {
+			continue
+		}
+		if ok, _ := path.Match(f.Path, p); f.Path == "" || ok {
+			fault = f
+			if f.Times > 0 {
+				if f.Times--; f.Times == 0 {
+					f.Times = -1
+				}
+			}
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	if fault != nil {
+		delay += fault.Delay
+	}
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if fault != nil {
+		switch {
+		case fault.Status >= 400:
+			writeError(w, fault.Status, r.URL.String())
+			return
+		case fault.Body != "":
+			w.WriteHeader(max(fault.Status, http.StatusOK))
+			fmt.Fprint(w, fault.Body)
+			return
+		}
+	}
+
+	s.mu.Lock()
+	data, items, ok := s.route(p, r.URL.Query())
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, r.URL.String())
+		return
+	}
+	if items != nil {
+		writePage(w, r.URL.Query(), items)
+		return
+	}
+	json.NewEncoder(w).Encode(struct {
+		Data any `json:"data"`
+	}{data})
+}
+
+func writeError(w http.ResponseWriter, status int, u string) {
+	e := jikan.Error{
+		Status:  status,
+		Type:    "BadResponseException",
+		Message: http.StatusText(status),
+		Err:     fmt.Sprintf("%d on %s", status, u),
+	}
+	if status >= 500 {
+		e.ReportURL = "https://github.com/jikan-me/jikan-rest/issues/new"
+	}
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(e)
+}
+
+func writePage(w http.ResponseWriter, q url.Values, items []json.RawMessage) {
+	page, _ := strconv.Atoi(q.Get("page"))
+	page = max(page, 1)
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	if limit <= 0 || limit > perPage {
+		limit = perPage
+	}
+	last := max((len(items)+limit-1)/limit, 1)
+	lo := min((page-1)*limit, len(items))
+	hi := min(lo+limit, len(items))
+
+	var pg jikan.Pagination
+	pg.LastPage = last
+	pg.CurrentPage = page
+	pg.HasNext = page < last
+	pg.Items = jikan.PaginationItems{Count: hi - lo, Total: len(items), PerPage: limit}
+	json.NewEncoder(w).Encode(struct {
+		Data       []json.RawMessage `json:"data"`
+		Pagination jikan.Pagination  `json:"pagination"`
+	}{items[lo:hi], pg})
+}
@@ -3,8 +3,9 @@ package jikan
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"net/http"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -20,22 +21,113 @@ type Magazine struct {
 	Count int    `json:"count"`
 }
 
+type MagazineOrder string
+
+const (
+	MagazineOrderMalID MagazineOrder = "mal_id"
+	MagazineOrderName  MagazineOrder = "name"
+	MagazineOrderCount MagazineOrder = "count"
+)
+
+type MagazineSearchOptions struct {
+	Query   string
+	OrderBy MagazineOrder
+	Sort    SortDirection
+	Letter  string
+	Page    int
+	Limit   int
+}
+
+func (o MagazineSearchOptions) ToValues() url.Values {
+	v := url.Values{}
+	if o.Query != "" {
+		v.Set("q", o.Query)
+	}
+	if o.OrderBy != "" {
+		v.Set("order_by", string(o.OrderBy))
+	}
+	if o.Sort != "" {
+		v.Set("sort", string(o.Sort))
+	}
+	if o.Letter != "" {
+		v.Set("letter", o.Letter)
+	}
+	if o.Page > 0 {
+		v.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return v
+}
+
+func (o MagazineSearchOptions) validate() error {
+	return validate(
+		checkEnum("order_by", o.OrderBy, MagazineOrderMalID, MagazineOrderName, MagazineOrderCount),
+		checkEnum("sort", o.Sort, SortAsc, SortDesc),
+		checkLetter(o.Letter),
+		checkPage(o.Page),
+		checkLimit(o.Limit),
+	)
+}
+
+// ByID looks id up in the magazine list, which is walked in ID order up
+// to it. Jikan has no /magazines/{id} route; errors.Is(err, ErrNotFound)
+// holds for an id the list does not have.
 func (s *MagazineService) ByID(ctx context.Context, id ID) (*Magazine, error) {
-	var r struct{ Data Magazine }
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/magazines/%d", id), nil, &r); err != nil {
+	if err := checkID("magazine id", id); err != nil {
+		return nil, err
+	}
+	res := s.byIDs(ctx, []ID{id})
+	if err := res.Errors[id]; err != nil {
 		return nil, err
 	}
-	return &r.Data, nil
+	return res.Items[id], nil
 }
 
-func (s *MagazineService) Search(ctx context.Context, query string, page int) ([]Magazine, *Pagination, error) {
-	q := url.Values{"q": {query}, "page": {strconv.Itoa(page)}}
-	var r struct {
-		Data       []Magazine `json:"data"`
-		Pagination Pagination `json:"pagination"`
+// ByIDRaw is ByID plus the magazine's JSON as the list sent it.
+func (s *MagazineService) ByIDRaw(ctx context.Context, id ID) (*Magazine, json.RawMessage, error) {
+	if err := checkID("magazine id", id); err != nil {
+		return nil, nil, err
 	}
-	if err := s.c.Do(ctx, http.MethodGet, "/magazines", q, &r); err != nil {
+	for raw, err := range paginate[json.RawMessage](ctx, s.c, "/magazines", byMalID(), nil) {
+		if err != nil {
+			return nil, nil, err
+		}
+		var m Magazine
+		if err := json.Unmarshal(raw, &m); err != nil {
+			return nil, nil, err
+		}
+		if m.MalID == id {
+			return &m, raw, nil
+		}
+		if m.MalID > id {
+			break
+		}
+	}
+	return nil, nil, fmt.Errorf("%w: magazine %d", ErrNotFound, id)
+}
+
+// byIDs resolves ids with a single walk over the magazine list.
+func (s *MagazineService) byIDs(ctx context.Context, ids []ID) *BatchResult[Magazine] {
+	list := paginate[*Magazine](ctx, s.c, "/magazines", byMalID(), nil)
+	return fromList(list, func(m *Magazine) ID { return m.MalID }, ids, true, "magazine")
+}
+
+func byMalID() url.Values {
+	return url.Values{"order_by": {string(MagazineOrderMalID)}, "sort": {string(SortAsc)}}
+}
+
+func (s *MagazineService) Search(ctx context.Context, opts MagazineSearchOptions) ([]Magazine, *Pagination, error) {
+	if err := opts.validate(); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
+	return fetchPaged[[]Magazine](ctx, s.c, "/magazines", opts.ToValues())
+}
+
+func (s *MagazineService) SearchAll(ctx context.Context, opts MagazineSearchOptions, pageOpts ...PageOption) iter.Seq2[Magazine, error] {
+	if err := opts.validate(); err != nil {
+		return failed[Magazine](err)
+	}
+	return paginate[Magazine](ctx, s.c, "/magazines", opts.ToValues(), pageOpts)
 }
@@ -2,51 +2,48 @@ package jikan
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"time"
 )
 
 type MangaService struct {
 	c *Client
 }
 
-func (s *MangaService) validateID(id ID) error {
-	if id < 1 {
-		return fmt.Errorf("invalid manga id: %d", id)
-	}
-	return nil
-}
-
 type Manga struct {
-	MalID          ID         `json:"mal_id"`
-	URL            string     `json:"url"`
-	Images         ImageSet   `json:"images"`
-	Title          string     `json:"title"`
-	TitleEnglish   string     `json:"title_english"`
-	TitleJapanese  string     `json:"title_japanese"`
-	TitleSynonyms  []string   `json:"title_synonyms"`
-	Type           string     `json:"type"`
-	Chapters       *int       `json:"chapters"`
-	Volumes        *int       `json:"volumes"`
-	Status         string     `json:"status"`
-	Publishing     bool       `json:"publishing"`
-	Published      DateRange  `json:"published"`
-	Score          *float64   `json:"score"`
-	ScoredBy       *int       `json:"scored_by"`
-	Rank           *int       `json:"rank"`
-	Popularity     *int       `json:"popularity"`
-	Members        *int       `json:"members"`
-	Favorites      *int       `json:"favorites"`
-	Synopsis       string     `json:"synopsis"`
-	Background     string     `json:"background"`
-	Authors        []Resource `json:"authors"`
-	Serializations []Resource `json:"serializations"`
-	Genres         []Resource `json:"genres"`
-	ExplicitGenres []Resource `json:"explicit_genres"`
-	Themes         []Resource `json:"themes"`
-	Demographics   []Resource `json:"demographics"`
+	MalID          ID                `json:"mal_id"`
+	URL            string            `json:"url"`
+	Images         ImageSet          `json:"images"`
+	Title          string            `json:"title"`
+	TitleEnglish   string            `json:"title_english"`
+	TitleJapanese  string            `json:"title_japanese"`
+	TitleSynonyms  []string          `json:"title_synonyms"`
+	Titles         []Title           `json:"titles"`
+	Approved       bool              `json:"approved"`
+	Type           MangaType         `json:"type"`
+	Chapters       Optional[int]     `json:"chapters"`
+	Volumes        Optional[int]     `json:"volumes"`
+	Status         MangaStatus       `json:"status"`
+	Publishing     bool              `json:"publishing"`
+	Published      DateRange         `json:"published"`
+	Score          Optional[float64] `json:"score"`
+	Scored         Optional[float64] `json:"scored"`
+	ScoredBy       Optional[int]     `json:"scored_by"`
+	Rank           Optional[int]     `json:"rank"`
+	Popularity     Optional[int]     `json:"popularity"`
+	Members        Optional[int]     `json:"members"`
+	Favorites      Optional[int]     `json:"favorites"`
+	Synopsis       string            `json:"synopsis"`
+	Background     string            `json:"background"`
+	Authors        []Resource        `json:"authors"`
+	Serializations []Resource        `json:"serializations"`
+	Genres         []Resource        `json:"genres"`
+	ExplicitGenres []Resource        `json:"explicit_genres"`
+	Themes         []Resource        `json:"themes"`
+	Demographics   []Resource        `json:"demographics"`
 }
 
 type MangaFull struct {
@@ -56,43 +53,17 @@ type MangaFull struct {
 }
 
 type MangaCharacter struct {
-	Character struct {
-		MalID  ID       `json:"mal_id"`
-		URL    string   `json:"url"`
-		Images ImageSet `json:"images"`
-		Name   string   `json:"name"`
-	} `json:"character"`
-	Role string `json:"role"`
+	Character CharacterMeta `json:"character"`
+	Role      string        `json:"role"`
 }
 
-type MangaNews struct {
-	MalID          int       `json:"mal_id"`
-	URL            string    `json:"url"`
-	Title          string    `json:"title"`
-	Date           time.Time `json:"date"`
-	AuthorUsername string    `json:"author_username"`
-	AuthorURL      string    `json:"author_url"`
-	ForumURL       string    `json:"forum_url"`
-	Images         ImageSet  `json:"images"`
-	Comments       int       `json:"comments"`
-	Excerpt        string    `json:"excerpt"`
-}
+type MangaNews = NewsArticle
 
-type MangaTopic struct {
-	MalID          int       `json:"mal_id"`
-	URL            string    `json:"url"`
-	Title          string    `json:"title"`
-	Date           time.Time `json:"date"`
-	AuthorUsername string    `json:"author_username"`
-	AuthorURL      string    `json:"author_url"`
-	Comments       int       `json:"comments"`
-	LastComment    struct {
-		URL            string    `json:"url"`
-		AuthorUsername string    `json:"author_username"`
-		AuthorURL      string    `json:"author_url"`
-		Date           time.Time `json:"date"`
-	} `json:"last_comment"`
-}
+type MangaTopic = ForumTopic
+
+type MangaRecommendation = EntryRecommendation
+
+type MangaRelation = Relation
 
 type MangaStats struct {
 	Reading    int          `json:"reading"`
@@ -104,85 +75,28 @@ type MangaStats struct {
 	Scores     []ScoreStats `json:"scores"`
 }
 
-type ScoreStats struct {
-	Score      int     `json:"score"`
-	Votes      int     `json:"votes"`
-	Percentage float64 `json:"percentage"`
-}
-
-type MangaRecommendation struct {
-	Entry struct {
-		MalID  ID       `json:"mal_id"`
-		URL    string   `json:"url"`
-		Images ImageSet `json:"images"`
-		Title  string   `json:"title"`
-	} `json:"entry"`
-	URL   string `json:"url"`
-	Votes int    `json:"votes"`
-}
-
 type MangaUserUpdate struct {
-	User struct {
-		Username string   `json:"username"`
-		URL      string   `json:"url"`
-		Images   ImageSet `json:"images"`
-	} `json:"user"`
-	Score         *int      `json:"score"`
-	Status        string    `json:"status"`
-	ChaptersRead  int       `json:"chapters_read"`
-	ChaptersTotal *int      `json:"chapters_total"`
-	VolumesRead   int       `json:"volumes_read"`
-	VolumesTotal  *int      `json:"volumes_total"`
-	Date          time.Time `json:"date"`
-}
-
-type MangaReview struct {
-	MalID         int             `json:"mal_id"`
-	URL           string          `json:"url"`
-	Type          string          `json:"type"`
-	Reactions     ReviewReactions `json:"reactions"`
-	Date          time.Time       `json:"date"`
-	Review        string          `json:"review"`
-	Score         int             `json:"score"`
-	Tags          []string        `json:"tags"`
-	IsSpoiler     bool            `json:"is_spoiler"`
-	IsPreliminary bool            `json:"is_preliminary"`
-	ChaptersRead  int             `json:"chapters_read"`
-	User          struct {
-		Username string   `json:"username"`
-		URL      string   `json:"url"`
-		Images   ImageSet `json:"images"`
-	} `json:"user"`
-}
-
-type ReviewReactions struct {
-	Overall     int `json:"overall"`
-	Nice        int `json:"nice"`
-	LoveIt      int `json:"love_it"`
-	Funny       int `json:"funny"`
-	Confusing   int `json:"confusing"`
-	Informative int `json:"informative"`
-	WellWritten int `json:"well_written"`
-	Creative    int `json:"creative"`
-}
-
-type MangaRelation struct {
-	Relation string     `json:"relation"`
-	Entry    []Resource `json:"entry"`
-}
-
-type ExternalLink struct {
-	Name string `json:"name"`
-	URL  string `json:"url"`
+	User          UserMeta      `json:"user"`
+	Score         Optional[int] `json:"score"`
+	Status        string        `json:"status"`
+	ChaptersRead  int           `json:"chapters_read"`
+	ChaptersTotal Optional[int] `json:"chapters_total"`
+	VolumesRead   int           `json:"volumes_read"`
+	VolumesTotal  Optional[int] `json:"volumes_total"`
+	Date          Date          `json:"date"`
 }
 
 type MangaSearchOptions struct {
 	Query   string
-	Type    string
-	Status  string
-	OrderBy string
-	Sort    string
-	Page    int
+	Type    MangaType
+	Status  MangaStatus
+	OrderBy MangaOrder
+	Sort    SortDirection
+	// StartDate and EndDate filter by publishing dates given as YYYY,
+	// YYYY-MM or YYYY-MM-DD.
+	StartDate string
+	EndDate   string
+	Page      int
 }
 
 func (o MangaSearchOptions) ToValues() url.Values {
@@ -191,16 +105,22 @@ func (o MangaSearchOptions) ToValues() url.Values {
 		v.Set("q", o.Query)
 	}
 	if o.Type != "" {
-		v.Set("type", o.Type)
+		v.Set("type", string(o.Type))
 	}
 	if o.Status != "" {
-		v.Set("status", o.Status)
+		v.Set("status", string(o.Status))
 	}
 	if o.OrderBy != "" {
-		v.Set("order_by", o.OrderBy)
+		v.Set("order_by", string(o.OrderBy))
 	}
 	if o.Sort != "" {
-		v.Set("sort", o.Sort)
+		v.Set("sort", string(o.Sort))
+	}
+	if o.StartDate != "" {
+		v.Set("start_date", o.StartDate)
+	}
+	if o.EndDate != "" {
+		v.Set("end_date", o.EndDate)
 	}
 	if o.Page > 0 {
 		v.Set("page", strconv.Itoa(o.Page))
@@ -208,8 +128,22 @@ func (o MangaSearchOptions) ToValues() url.Values {
 	return v
 }
 
+func (o MangaSearchOptions) validate() error {
+	return validate(
+		checkKnown("type", o.Type),
+		checkKnown("status", o.Status),
+		checkEnum("order_by", o.OrderBy, MangaOrderMalID, MangaOrderTitle, MangaOrderStartDate, MangaOrderEndDate,
+			MangaOrderChapters, MangaOrderVolumes, MangaOrderScore, MangaOrderScoredBy, MangaOrderRank,
+			MangaOrderPopularity, MangaOrderMembers, MangaOrderFavorites),
+		checkEnum("sort", o.Sort, SortAsc, SortDesc),
+		checkDate("start_date", o.StartDate),
+		checkDate("end_date", o.EndDate),
+		checkPage(o.Page),
+	)
+}
+
 func (s *MangaService) ByID(ctx context.Context, id ID) (*Manga, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("manga id", id); err != nil {
 		return nil, err
 	}
 	r, err := fetch[Manga](ctx, s.c, fmt.Sprintf("/manga/%d", id), nil)
@@ -219,8 +153,24 @@ func (s *MangaService) ByID(ctx context.Context, id ID) (*Manga, error) {
 	return &r, nil
 }
 
+// ByIDRaw is ByID plus the manga JSON as Jikan sent it.
+func (s *MangaService) ByIDRaw(ctx context.Context, id ID) (*Manga, json.RawMessage, error) {
+	if err := checkID("manga id", id); err != nil {
+		return nil, nil, err
+	}
+	r, raw, err := fetchRaw[Manga](ctx, s.c, fmt.Sprintf("/manga/%d", id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &r, raw, nil
+}
+
+func (s *MangaService) ByIDs(ctx context.Context, ids []ID, opts BatchOptions) *BatchResult[Manga] {
+	return batch(ctx, ids, opts, s.ByID)
+}
+
 func (s *MangaService) Full(ctx context.Context, id ID) (*MangaFull, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("manga id", id); err != nil {
 		return nil, err
 	}
 	r, err := fetch[MangaFull](ctx, s.c, fmt.Sprintf("/manga/%d/full", id), nil)
@@ -231,25 +181,29 @@ func (s *MangaService) Full(ctx context.Context, id ID) (*MangaFull, error) {
 }
 
 func (s *MangaService) Characters(ctx context.Context, id ID) ([]MangaCharacter, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("manga id", id); err != nil {
 		return nil, err
 	}
 	return fetch[[]MangaCharacter](ctx, s.c, fmt.Sprintf("/manga/%d/characters", id), nil)
 }
 
 func (s *MangaService) News(ctx context.Context, id ID, page int) ([]MangaNews, *Pagination, error) {
-	if err := s.validateID(id); err != nil {
+	if err := validate(checkID("manga id", id), checkPage(page)); err != nil {
 		return nil, nil, err
 	}
-	q := url.Values{}
-	if page > 0 {
-		q.Set("page", strconv.Itoa(page))
-	}
+	q := pageQuery(page)
 	return fetchPaged[[]MangaNews](ctx, s.c, fmt.Sprintf("/manga/%d/news", id), q)
 }
 
+func (s *MangaService) NewsAll(ctx context.Context, id ID, pageOpts ...PageOption) iter.Seq2[MangaNews, error] {
+	if err := checkID("manga id", id); err != nil {
+		return failed[MangaNews](err)
+	}
+	return paginate[MangaNews](ctx, s.c, fmt.Sprintf("/manga/%d/news", id), nil, pageOpts)
+}
+
 func (s *MangaService) Forum(ctx context.Context, id ID, filter string) ([]MangaTopic, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("manga id", id); err != nil {
 		return nil, err
 	}
 	q := url.Values{}
@@ -260,14 +214,24 @@ func (s *MangaService) Forum(ctx context.Context, id ID, filter string) ([]Manga
 }
 
 func (s *MangaService) Pictures(ctx context.Context, id ID) ([]ImageSet, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("manga id", id); err != nil {
 		return nil, err
 	}
-	return fetch[[]ImageSet](ctx, s.c, fmt.Sprintf("/manga/%d/pictures", id), nil)
+	r, err := fetch[[]struct {
+		Images ImageSet `json:"images"`
+	}](ctx, s.c, fmt.Sprintf("/manga/%d/pictures", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	sets := make([]ImageSet, len(r))
+	for i, d := range r {
+		sets[i] = d.Images
+	}
+	return sets, nil
 }
 
 func (s *MangaService) Statistics(ctx context.Context, id ID) (*MangaStats, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("manga id", id); err != nil {
 		return nil, err
 	}
 	r, err := fetch[MangaStats](ctx, s.c, fmt.Sprintf("/manga/%d/statistics", id), nil)
@@ -278,7 +242,7 @@ func (s *MangaService) Statistics(ctx context.Context, id ID) (*MangaStats, erro
 }
 
 func (s *MangaService) MoreInfo(ctx context.Context, id ID) (string, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("manga id", id); err != nil {
 		return "", err
 	}
 	type moreInfo struct {
@@ -289,31 +253,32 @@ func (s *MangaService) MoreInfo(ctx context.Context, id ID) (string, error) {
 }
 
 func (s *MangaService) Recommendations(ctx context.Context, id ID) ([]MangaRecommendation, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("manga id", id); err != nil {
 		return nil, err
 	}
 	return fetch[[]MangaRecommendation](ctx, s.c, fmt.Sprintf("/manga/%d/recommendations", id), nil)
 }
 
 func (s *MangaService) UserUpdates(ctx context.Context, id ID, page int) ([]MangaUserUpdate, *Pagination, error) {
-	if err := s.validateID(id); err != nil {
+	if err := validate(checkID("manga id", id), checkPage(page)); err != nil {
 		return nil, nil, err
 	}
-	q := url.Values{}
-	if page > 0 {
-		q.Set("page", strconv.Itoa(page))
-	}
+	q := pageQuery(page)
 	return fetchPaged[[]MangaUserUpdate](ctx, s.c, fmt.Sprintf("/manga/%d/userupdates", id), q)
 }
 
+func (s *MangaService) UserUpdatesAll(ctx context.Context, id ID, pageOpts ...PageOption) iter.Seq2[MangaUserUpdate, error] {
+	if err := checkID("manga id", id); err != nil {
+		return failed[MangaUserUpdate](err)
+	}
+	return paginate[MangaUserUpdate](ctx, s.c, fmt.Sprintf("/manga/%d/userupdates", id), nil, pageOpts)
+}
+
 func (s *MangaService) Reviews(ctx context.Context, id ID, page int, preliminary, spoiler bool) ([]MangaReview, *Pagination, error) {
-	if err := s.validateID(id); err != nil {
+	if err := validate(checkID("manga id", id), checkPage(page)); err != nil {
 		return nil, nil, err
 	}
-	q := url.Values{}
-	if page > 0 {
-		q.Set("page", strconv.Itoa(page))
-	}
+	q := pageQuery(page)
 	if preliminary {
 		q.Set("preliminary", "true")
 	}
@@ -323,20 +288,44 @@ func (s *MangaService) Reviews(ctx context.Context, id ID, page int, preliminary
 	return fetchPaged[[]MangaReview](ctx, s.c, fmt.Sprintf("/manga/%d/reviews", id), q)
 }
 
+func (s *MangaService) ReviewsAll(ctx context.Context, id ID, preliminary, spoiler bool, pageOpts ...PageOption) iter.Seq2[MangaReview, error] {
+	if err := checkID("manga id", id); err != nil {
+		return failed[MangaReview](err)
+	}
+	q := url.Values{}
+	if preliminary {
+		q.Set("preliminary", "true")
+	}
+	if spoiler {
+		q.Set("spoiler", "true")
+	}
+	return paginate[MangaReview](ctx, s.c, fmt.Sprintf("/manga/%d/reviews", id), q, pageOpts)
+}
+
 func (s *MangaService) Relations(ctx context.Context, id ID) ([]MangaRelation, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("manga id", id); err != nil {
 		return nil, err
 	}
 	return fetch[[]MangaRelation](ctx, s.c, fmt.Sprintf("/manga/%d/relations", id), nil)
 }
 
 func (s *MangaService) External(ctx context.Context, id ID) ([]ExternalLink, error) {
-	if err := s.validateID(id); err != nil {
+	if err := checkID("manga id", id); err != nil {
 		return nil, err
 	}
 	return fetch[[]ExternalLink](ctx, s.c, fmt.Sprintf("/manga/%d/external", id), nil)
 }
 
 func (s *MangaService) Search(ctx context.Context, opts MangaSearchOptions) ([]*Manga, *Pagination, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
 	return fetchPaged[[]*Manga](ctx, s.c, "/manga", opts.ToValues())
 }
+
+func (s *MangaService) SearchAll(ctx context.Context, opts MangaSearchOptions, pageOpts ...PageOption) iter.Seq2[*Manga, error] {
+	if err := opts.validate(); err != nil {
+		return failed[*Manga](err)
+	}
+	return paginate[*Manga](ctx, s.c, "/manga", opts.ToValues(), pageOpts)
+}
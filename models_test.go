@@ -0,0 +1,45 @@
+package jikan_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Sethispr/jikanGo"
+)
+
+func TestRecommendationID(t *testing.T) {
+	var r jikan.Recommendation
+	if err := json.Unmarshal([]byte(`{"mal_id":"1-205"}`), &r); err != nil {
+		t.Fatal(err)
+	}
+	if want := (jikan.RecommendationID{From: 1, To: 205}); r.MalID != want {
+		t.Fatalf("MalID = %+v, want %+v", r.MalID, want)
+	}
+	b, err := json.Marshal(r.MalID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `"1-205"` {
+		t.Fatalf("Marshal = %s, want \"1-205\"", b)
+	}
+	for _, bad := range []string{`"1"`, `"a-2"`, `"1-"`} {
+		var id jikan.RecommendationID
+		if err := json.Unmarshal([]byte(bad), &id); err == nil {
+			t.Errorf("Unmarshal(%s) succeeded", bad)
+		}
+	}
+}
+
+func TestAnimeReviewScores(t *testing.T) {
+	var r jikan.AnimeReview
+	body := `{"mal_id":7406,"score":9,"reactions":{"overall":12,"nice":8},"user":{"username":"nekomata1037"}}`
+	if err := json.Unmarshal([]byte(body), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.MalID != 7406 || r.User.Username != "nekomata1037" {
+		t.Fatalf("schema fields not decoded: %+v", r)
+	}
+	if r.Votes != 12 || r.Scores.Overall != 9 {
+		t.Fatalf("Votes = %d, Scores.Overall = %d, want 12 and 9", r.Votes, r.Scores.Overall)
+	}
+}
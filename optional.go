@@ -0,0 +1,60 @@
+package jikan
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Optional holds a value Jikan may report as null, so that an unscored
+// entry can be told apart from one scored 0. It encodes back to null when
+// unset, which keeps cached responses identical to the originals.
+// Nullable strings are left as plain strings, with null decoding to "".
+type Optional[T any] struct {
+	Value T
+	Valid bool
+}
+
+// Some returns a set Optional holding v.
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{Value: v, Valid: true}
+}
+
+// Get returns the value and whether it was set.
+func (o Optional[T]) Get() (T, bool) { return o.Value, o.Valid }
+
+// ValueOr returns the value, or def when it is unset.
+func (o Optional[T]) ValueOr(def T) T {
+	if !o.Valid {
+		return def
+	}
+	return o.Value
+}
+
+// Ptr returns a pointer to a copy of the value, or nil when it is unset.
+func (o Optional[T]) Ptr() *T {
+	if !o.Valid {
+		return nil
+	}
+	v := o.Value
+	return &v
+}
+
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.Value)
+}
+
+func (o *Optional[T]) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*o = Optional[T]{}
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
@@ -3,6 +3,8 @@ package jikan
 import (
 	"net/http"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
 type Option func(*Client)
@@ -11,15 +13,21 @@ func WithHTTPClient(hc *http.Client) Option {
 	return func(c *Client) { c.client = hc }
 }
 
+// WithTimeout sets the timeout for each HTTP request. It copies the
+// current http.Client rather than changing it, so a client passed to
+// WithHTTPClient is left as it was.
 func WithTimeout(d time.Duration) Option {
 	return func(c *Client) {
-		c.client = &http.Client{
-			Timeout:   d,
-			Transport: http.DefaultTransport,
-		}
+		hc := *c.client
+		hc.Timeout = d
+		c.client = &hc
 	}
 }
 
+func WithRetries(n int) Option {
+	return func(c *Client) { c.maxRetries = n }
+}
+
 func WithCache(cache Cache, ttl time.Duration) Option {
 	return func(c *Client) {
 		c.cache = cache
@@ -27,6 +35,20 @@ func WithCache(cache Cache, ttl time.Duration) Option {
 	}
 }
 
-func WithRetries(n int) Option {
-	return func(c *Client) { c.maxRetries = n }
+// WithRateLimit enables request rate limiting at rps requests per second.
+// Pass 0 or negative to use default (3 rps).
+func WithRateLimit(rps int) Option {
+	return func(c *Client) {
+		if rps <= 0 {
+			rps = defaultRPS
+		}
+		c.limiter = rate.NewLimiter(rate.Every(time.Second/time.Duration(rps)), defaultBurst)
+	}
+}
+
+// WithRateLimiter accepts a pre configured rate limiter for more customizable control.
+func WithRateLimiter(l *rate.Limiter) Option {
+	return func(c *Client) {
+		c.limiter = l
+	}
 }
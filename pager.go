@@ -0,0 +1,321 @@
+package jikan
+
+import (
+	"context"
+	"iter"
+	"maps"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+type pageConfig struct {
+	start    int
+	maxPages int
+	maxItems int
+	workers  int
+	cursor   *Cursor
+	store    CheckpointStore
+	key      string
+}
+
+// PageOption bounds the iterators returned by the *All methods.
+type PageOption func(*pageConfig)
+
+// StartPage begins iteration at page n instead of page 1.
+func StartPage(n int) PageOption {
+	return func(c *pageConfig) { c.start = n }
+}
+
+// MaxPages stops iteration after n pages have been fetched.
+func MaxPages(n int) PageOption {
+	return func(c *pageConfig) { c.maxPages = n }
+}
+
+// MaxItems stops iteration after n items have been yielded.
+func MaxItems(n int) PageOption {
+	return func(c *pageConfig) { c.maxItems = n }
+}
+
+// Concurrency fetches up to n pages at once. The first page is fetched on
+// its own to learn the last page, the rest are spread over n workers that
+// share the client's rate limiter. Items are still yielded in page order.
+func Concurrency(n int) PageOption {
+	return func(c *pageConfig) { c.workers = n }
+}
+
+// WithCursor resumes from cur if it has progress recorded and keeps it up
+// to date as items are yielded, so it can be saved at any point.
+func WithCursor(cur *Cursor) PageOption {
+	return func(c *pageConfig) { c.cursor = cur }
+}
+
+// Checkpoint loads the cursor stored under key before the walk starts and
+// saves it after every page, and when the walk stops partway through one.
+func Checkpoint(store CheckpointStore, key string) PageOption {
+	return func(c *pageConfig) {
+		c.store = store
+		c.key = key
+	}
+}
+
+func newPageConfig(opts []PageOption) pageConfig {
+	cfg := pageConfig{start: 1}
+	for _, o := range opts {
+		o(&cfg)
+	}
+	if cfg.start < 1 {
+		cfg.start = 1
+	}
+	return cfg
+}
+
+// pageWalk is the state of one run over a paginated endpoint.
+type pageWalk struct {
+	cfg    pageConfig
+	path   string
+	query  url.Values
+	cursor Cursor
+	start  int
+	items  int
+}
+
+func newPageWalk(ctx context.Context, cfg pageConfig, path string, query url.Values) (*pageWalk, error) {
+	q := maps.Clone(query)
+	if q == nil {
+		q = url.Values{}
+	}
+	q.Del("page")
+	w := &pageWalk{cfg: cfg, path: path, query: q, start: cfg.start}
+	w.cursor = Cursor{Endpoint: path, Query: q.Encode()}
+
+	var saved *Cursor
+	if cfg.store != nil {
+		cur, err := cfg.store.Load(ctx, cfg.key)
+		if err != nil {
+			return nil, err
+		}
+		saved = cur
+	}
+	if saved == nil && cfg.cursor != nil && cfg.cursor.Page > 0 {
+		saved = cfg.cursor
+	}
+	if saved != nil {
+		if err := w.cursor.matches(saved); err != nil {
+			return nil, err
+		}
+		w.cursor = *saved
+		w.start = saved.Page + 1
+	}
+	if cfg.cursor != nil {
+		*cfg.cursor = w.cursor
+	}
+	return w, nil
+}
+
+func (w *pageWalk) values(page int) url.Values {
+	q := maps.Clone(w.query)
+	q.Set("page", strconv.Itoa(page))
+	return q
+}
+
+// emit yields the items of page, skipping those a resumed walk already
+// yielded, records the progress made and reports whether the walk should
+// continue. A page cut short by MaxItems or by the consumer breaking out
+// is saved with the offset reached, so resuming picks up mid-page.
+func emit[T any](ctx context.Context, w *pageWalk, yield func(T, error) bool, page int, data []T, last bool) bool {
+	skip := min(w.cursor.Offset, len(data))
+	n, more, listening := skip, true, true
+	for n < len(data) {
+		v := data[n]
+		n++
+		if !yield(v, nil) {
+			more, listening = false, false
+			break
+		}
+		w.items++
+		if w.cfg.maxItems > 0 && w.items >= w.cfg.maxItems {
+			more = false
+			break
+		}
+	}
+	if err := w.progress(ctx, page, n-skip, n < len(data), last); err != nil {
+		if listening {
+			var zero T
+			yield(zero, err)
+		}
+		return false
+	}
+	return more
+}
+
+// progress adds n yielded items to the cursor and persists it. A partial
+// page keeps the cursor on the page before it with Offset set; a finished
+// one moves the cursor onto it.
+func (w *pageWalk) progress(ctx context.Context, page, n int, partial, last bool) error {
+	w.cursor.Items += n
+	if partial {
+		w.cursor.Offset += n
+	} else {
+		w.cursor.Page = page
+		w.cursor.Offset = 0
+		w.cursor.Done = last
+	}
+	if w.cfg.cursor != nil {
+		*w.cfg.cursor = w.cursor
+	}
+	if w.cfg.store != nil {
+		return w.cfg.store.Save(ctx, w.cfg.key, w.cursor)
+	}
+	return nil
+}
+
+// paginate walks a paginated endpoint one page at a time using fetchPaged,
+// yielding each item in order. Any page in query is overwritten. Iteration
+// stops at the last page, at the configured limits, when the consumer
+// breaks out of the loop, or after yielding the first error.
+func paginate[T any](ctx context.Context, c *Client, path string, query url.Values, opts []PageOption) iter.Seq2[T, error] {
+	cfg := newPageConfig(opts)
+	return func(yield func(T, error) bool) {
+		var zero T
+		w, err := newPageWalk(ctx, cfg, path, query)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		if w.cursor.Done {
+			return
+		}
+		if cfg.workers > 1 {
+			walkParallel(ctx, c, w, yield)
+			return
+		}
+		walk(ctx, c, w, yield, w.start, 0)
+	}
+}
+
+// walk fetches one page at a time from page on. fetched is the number of
+// pages already fetched, which counts against MaxPages.
+func walk[T any](ctx context.Context, c *Client, w *pageWalk, yield func(T, error) bool, page, fetched int) {
+	var zero T
+	for ; w.cfg.maxPages <= 0 || fetched < w.cfg.maxPages; page, fetched = page+1, fetched+1 {
+		if err := ctx.Err(); err != nil {
+			yield(zero, err)
+			return
+		}
+		data, pg, err := fetchPaged[[]T](ctx, c, w.path, w.values(page))
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		end := !pg.HasNext || len(data) == 0
+		if !emit(ctx, w, yield, page, data, end) || end {
+			return
+		}
+	}
+}
+
+type pageResult[T any] struct {
+	data []T
+	err  error
+}
+
+// walkParallel is the Concurrency variant of paginate. Pages are handed to
+// the workers in order and each result lands in its own slot, so the
+// consumer can read them back in order. At most twice the worker count of
+// pages are held ahead of the consumer.
+func walkParallel[T any](ctx context.Context, c *Client, w *pageWalk, yield func(T, error) bool) {
+	var zero T
+	first, pg, err := fetchPaged[[]T](ctx, c, w.path, w.values(w.start))
+	if err != nil {
+		yield(zero, err)
+		return
+	}
+	end := !pg.HasNext || len(first) == 0
+	if !emit(ctx, w, yield, w.start, first, end) || end {
+		return
+	}
+	if pg.LastPage <= w.start {
+		// Some lists leave last_visible_page out, so there is nothing to
+		// spread over the workers; go on one page at a time instead.
+		walk(ctx, c, w, yield, w.start+1, 1)
+		return
+	}
+	last := pg.LastPage
+	if w.cfg.maxPages > 0 {
+		last = min(last, w.start+w.cfg.maxPages-1)
+	}
+	if last <= w.start {
+		return
+	}
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	n := last - w.start
+	slots := make([]chan pageResult[T], n)
+	for i := range slots {
+		slots[i] = make(chan pageResult[T], 1)
+	}
+	window := make(chan struct{}, 2*w.cfg.workers)
+	jobs := make(chan int)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(jobs)
+		for i := range n {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for range min(w.cfg.workers, n) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				data, _, err := fetchPaged[[]T](ctx, c, w.path, w.values(w.start+1+i))
+				slots[i] <- pageResult[T]{data: data, err: err}
+			}
+		}()
+	}
+
+	for i := range n {
+		var res pageResult[T]
+		select {
+		case res = <-slots[i]:
+		case <-ctx.Done():
+			yield(zero, ctx.Err())
+			return
+		}
+		if res.err != nil {
+			yield(zero, res.err)
+			return
+		}
+		page := w.start + 1 + i
+		if !emit(ctx, w, yield, page, res.data, page == pg.LastPage || len(res.data) == 0) {
+			return
+		}
+		<-window
+	}
+}
+
+// failed returns an iterator that yields err once. It lets *All methods
+// report argument errors through the same channel as request errors.
+func failed[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
@@ -0,0 +1,275 @@
+package jikan_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Sethispr/jikanGo"
+	"github.com/Sethispr/jikanGo/jikantest"
+)
+
+// pageTransport delays earlier pages more than later ones, so parallel
+// fetches complete out of order, and fails the page in fail.
+type pageTransport struct {
+	fail int
+}
+
+func (t pageTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+	if page == t.fail {
+		return nil, errors.New("connection reset")
+	}
+	time.Sleep(time.Duration(max(10-page, 0)) * time.Millisecond)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newPagedClient(t *testing.T, n, fail int) (*jikan.Client, *jikantest.Server) {
+	t.Helper()
+	items := make([]jikan.Anime, n)
+	for i := range items {
+		items[i] = jikan.Anime{MalID: jikan.ID(i + 1)}
+	}
+	c, srv := jikantest.NewClient(t, jikan.WithHTTPClient(&http.Client{Transport: pageTransport{fail: fail}}))
+	jikantest.SetPaged(srv, "/seasons/now", items)
+	return c, srv
+}
+
+func collect(t *testing.T, c *jikan.Client, opts ...jikan.PageOption) ([]jikan.ID, error) {
+	t.Helper()
+	var ids []jikan.ID
+	for a, err := range c.Season.NowAll(context.Background(), jikan.SeasonOptions{}, opts...) {
+		if err != nil {
+			return ids, err
+		}
+		ids = append(ids, a.MalID)
+	}
+	return ids, nil
+}
+
+func checkSequence(t *testing.T, ids []jikan.ID, want int) {
+	t.Helper()
+	checkRange(t, ids, 1, want)
+}
+
+// checkRange checks that ids counts up from first and has want entries.
+func checkRange(t *testing.T, ids []jikan.ID, first, want int) {
+	t.Helper()
+	if len(ids) != want {
+		t.Fatalf("got %d items, want %d", len(ids), want)
+	}
+	for i, id := range ids {
+		if id != jikan.ID(first+i) {
+			t.Fatalf("item %d has id %d, want %d", i, id, first+i)
+		}
+	}
+}
+
+// listPage is one page sent by a list server. A zero last leaves
+// last_visible_page out, as some Jikan lists do.
+type listPage struct {
+	items   int
+	hasNext bool
+	last    int
+}
+
+// newListClient serves pages on /seasons/now with the pagination given,
+// numbering items from 1 across pages. Pages past the end are empty with
+// has_next_page false. The counter is the number of requests served.
+func newListClient(t *testing.T, pages ...listPage) (*jikan.Client, *atomic.Int32) {
+	t.Helper()
+	var n atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n.Add(1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		page = max(page, 1)
+		first := 1
+		for _, p := range pages[:min(page-1, len(pages))] {
+			first += p.items
+		}
+		var p listPage
+		if page <= len(pages) {
+			p = pages[page-1]
+		}
+		data := make([]jikan.Anime, p.items)
+		for i := range data {
+			data[i] = jikan.Anime{MalID: jikan.ID(first + i)}
+		}
+		pg := map[string]any{"current_page": page, "has_next_page": p.hasNext}
+		if p.last > 0 {
+			pg["last_visible_page"] = p.last
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": data, "pagination": pg})
+	}))
+	t.Cleanup(srv.Close)
+	return jikan.New(jikan.WithBaseURL(srv.URL + "/v4")), &n
+}
+
+func TestPagesLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []jikan.PageOption
+		first    int
+		items    int
+		requests int
+	}{
+		{"all", nil, 1, 60, 3},
+		{"StartPage", []jikan.PageOption{jikan.StartPage(2)}, 26, 35, 2},
+		{"StartPage past the end", []jikan.PageOption{jikan.StartPage(5)}, 1, 0, 1},
+		{"MaxPages", []jikan.PageOption{jikan.MaxPages(2)}, 1, 50, 2},
+		{"StartPage and MaxPages", []jikan.PageOption{jikan.StartPage(2), jikan.MaxPages(1)}, 26, 25, 1},
+		{"MaxItems within a page", []jikan.PageOption{jikan.MaxItems(30)}, 1, 30, 2},
+		{"MaxItems on a page boundary", []jikan.PageOption{jikan.MaxItems(25)}, 1, 25, 1},
+		{"MaxItems past the end", []jikan.PageOption{jikan.MaxItems(100)}, 1, 60, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, srv := newPagedClient(t, 60, 0)
+			ids, err := collect(t, c, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkRange(t, ids, tt.first, tt.items)
+			if n := len(srv.Requests()); n != tt.requests {
+				t.Errorf("made %d requests, want %d", n, tt.requests)
+			}
+		})
+	}
+}
+
+func TestPagesEnd(t *testing.T) {
+	tests := []struct {
+		name     string
+		pages    []listPage
+		items    int
+		requests int32
+	}{
+		{"empty last page", []listPage{{25, true, 0}, {25, true, 0}, {0, true, 0}}, 50, 3},
+		{"has_next_page false", []listPage{{25, true, 3}, {10, false, 3}, {25, true, 3}}, 35, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, n := newListClient(t, tt.pages...)
+			ids, err := collect(t, c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkSequence(t, ids, tt.items)
+			if got := n.Load(); got != tt.requests {
+				t.Errorf("made %d requests, want %d", got, tt.requests)
+			}
+		})
+	}
+}
+
+func TestPagesEarlyBreak(t *testing.T) {
+	c, srv := newPagedClient(t, 4*25, 0)
+	var ids []jikan.ID
+	for a, err := range c.Season.NowAll(context.Background(), jikan.SeasonOptions{}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ids = append(ids, a.MalID); len(ids) == 30 {
+			break
+		}
+	}
+	checkSequence(t, ids, 30)
+	if n := len(srv.Requests()); n != 2 {
+		t.Errorf("made %d requests, want 2", n)
+	}
+}
+
+func TestConcurrentPagesInOrder(t *testing.T) {
+	c, srv := newPagedClient(t, 6*25+5, 0)
+	ids, err := collect(t, c, jikan.Concurrency(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSequence(t, ids, 6*25+5)
+	if n := len(srv.Requests()); n != 7 {
+		t.Errorf("made %d requests, want 7", n)
+	}
+}
+
+func TestConcurrentPagesLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		opt      jikan.PageOption
+		items    int
+		requests int
+	}{
+		{"MaxPages", jikan.MaxPages(3), 75, 3},
+		{"MaxItems within first page", jikan.MaxItems(10), 10, 1},
+		{"MaxItems across pages", jikan.MaxItems(60), 60, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, srv := newPagedClient(t, 10*25, 0)
+			ids, err := collect(t, c, jikan.Concurrency(3), tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkSequence(t, ids, tt.items)
+			if n := len(srv.Requests()); tt.requests > 0 && n != tt.requests {
+				t.Errorf("made %d requests, want %d", n, tt.requests)
+			}
+		})
+	}
+}
+
+func TestConcurrentPagesError(t *testing.T) {
+	c, _ := newPagedClient(t, 8*25, 3)
+	ids, err := collect(t, c, jikan.Concurrency(4))
+	if !errors.Is(err, jikan.ErrUpstreamUnavailable) {
+		t.Fatalf("got error %v, want ErrUpstreamUnavailable", err)
+	}
+	checkSequence(t, ids, 2*25)
+}
+
+func TestConcurrentPagesEarlyBreak(t *testing.T) {
+	c, _ := newPagedClient(t, 20*25, 0)
+	var n int
+	for _, err := range c.Season.NowAll(context.Background(), jikan.SeasonOptions{}, jikan.Concurrency(4)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n++; n == 40 {
+			break
+		}
+	}
+	if n != 40 {
+		t.Fatalf("consumed %d items, want 40", n)
+	}
+}
+
+func TestConcurrentPagesWithoutLastPage(t *testing.T) {
+	pages := []listPage{{25, true, 0}, {25, true, 0}, {10, false, 0}}
+	tests := []struct {
+		name     string
+		opts     []jikan.PageOption
+		items    int
+		requests int32
+	}{
+		{"all", nil, 60, 3},
+		{"MaxPages", []jikan.PageOption{jikan.MaxPages(2)}, 50, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, n := newListClient(t, pages...)
+			ids, err := collect(t, c, append(tt.opts, jikan.Concurrency(4))...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkSequence(t, ids, tt.items)
+			if got := n.Load(); got != tt.requests {
+				t.Errorf("made %d requests, want %d", got, tt.requests)
+			}
+		})
+	}
+}
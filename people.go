@@ -2,6 +2,7 @@ package jikan
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -9,27 +10,49 @@ import (
 type PeopleService struct{ c *Client }
 
 type Person struct {
-	MalID          ID       `json:"mal_id"`
-	URL            string   `json:"url"`
-	Images         ImageSet `json:"images"`
-	Name           string   `json:"name"`
-	GivenName      string   `json:"given_name"`
-	FamilyName     string   `json:"family_name"`
-	AlternateNames []string `json:"alternate_names"`
-	Birthday       string   `json:"birthday"`
-	Favorites      int      `json:"favorites"`
-	About          string   `json:"about"`
-	VoiceRoles     []struct {
-		Role      string   `json:"role"`
-		Anime     Resource `json:"anime"`
-		Character Resource `json:"character"`
-	} `json:"voices"`
+	MalID          ID                `json:"mal_id"`
+	URL            string            `json:"url"`
+	Images         ImageSet          `json:"images"`
+	Name           string            `json:"name"`
+	GivenName      string            `json:"given_name"`
+	FamilyName     string            `json:"family_name"`
+	AlternateNames []string          `json:"alternate_names"`
+	Birthday       Date              `json:"birthday"`
+	Favorites      Optional[int]     `json:"favorites"`
+	WebsiteURL     string            `json:"website_url"`
+	About          string            `json:"about"`
+	VoiceRoles     []PersonVoiceRole `json:"voices,omitempty"`
+}
+
+type PersonVoiceRole struct {
+	Role      string   `json:"role"`
+	Anime     Resource `json:"anime"`
+	Character Resource `json:"character"`
 }
 
 func (s *PeopleService) ByID(ctx context.Context, id ID) (*Person, error) {
+	if err := checkID("person id", id); err != nil {
+		return nil, err
+	}
 	var r struct{ Data Person }
 	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/people/%d", id), nil, &r); err != nil {
 		return nil, err
 	}
 	return &r.Data, nil
 }
+
+// ByIDRaw is ByID plus the raw person JSON.
+func (s *PeopleService) ByIDRaw(ctx context.Context, id ID) (*Person, json.RawMessage, error) {
+	if err := checkID("person id", id); err != nil {
+		return nil, nil, err
+	}
+	r, raw, err := fetchRaw[Person](ctx, s.c, fmt.Sprintf("/people/%d", id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &r, raw, nil
+}
+
+func (s *PeopleService) ByIDs(ctx context.Context, ids []ID, opts BatchOptions) *BatchResult[Person] {
+	return batch(ctx, ids, opts, s.ByID)
+}
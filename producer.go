@@ -3,7 +3,9 @@ package jikan
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -16,14 +18,94 @@ type ProducerService struct {
 type Producer struct {
 	MalID       ID       `json:"mal_id"`
 	URL         string   `json:"url"`
+	Titles      []Title  `json:"titles"`
 	Images      ImageSet `json:"images"`
-	Title       string   `json:"title"`
-	Established string   `json:"established"`
+	Favorites   int      `json:"favorites"`
+	Established Date     `json:"established"`
 	About       string   `json:"about"`
 	Count       int      `json:"count"`
 }
 
+// Name returns the producer's default title, falling back to the first one listed.
+func (p *Producer) Name() string {
+	for _, t := range p.Titles {
+		if t.Language == "Default" {
+			return t.Title
+		}
+	}
+	if len(p.Titles) > 0 {
+		return p.Titles[0].Title
+	}
+	return ""
+}
+
+type ProducerFull struct {
+	Producer
+	External []ExternalLink `json:"external"`
+}
+
+type ProducerOrder string
+
+const (
+	ProducerOrderMalID       ProducerOrder = "mal_id"
+	ProducerOrderCount       ProducerOrder = "count"
+	ProducerOrderFavorites   ProducerOrder = "favorites"
+	ProducerOrderEstablished ProducerOrder = "established"
+)
+
+type SortDirection string
+
+const (
+	SortAsc  SortDirection = "asc"
+	SortDesc SortDirection = "desc"
+)
+
+type ProducerSearchOptions struct {
+	Query   string
+	OrderBy ProducerOrder
+	Sort    SortDirection
+	Letter  string
+	Page    int
+	Limit   int
+}
+
+func (o ProducerSearchOptions) ToValues() url.Values {
+	v := url.Values{}
+	if o.Query != "" {
+		v.Set("q", o.Query)
+	}
+	if o.OrderBy != "" {
+		v.Set("order_by", string(o.OrderBy))
+	}
+	if o.Sort != "" {
+		v.Set("sort", string(o.Sort))
+	}
+	if o.Letter != "" {
+		v.Set("letter", o.Letter)
+	}
+	if o.Page > 0 {
+		v.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return v
+}
+
+func (o ProducerSearchOptions) validate() error {
+	return validate(
+		checkEnum("order_by", o.OrderBy, ProducerOrderMalID, ProducerOrderCount, ProducerOrderFavorites, ProducerOrderEstablished),
+		checkEnum("sort", o.Sort, SortAsc, SortDesc),
+		checkLetter(o.Letter),
+		checkPage(o.Page),
+		checkLimit(o.Limit),
+	)
+}
+
 func (s *ProducerService) ByID(ctx context.Context, id ID) (*Producer, error) {
+	if err := checkID("producer id", id); err != nil {
+		return nil, err
+	}
 	var r struct{ Data Producer }
 	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/producers/%d", id), nil, &r); err != nil {
 		return nil, err
@@ -31,14 +113,50 @@ func (s *ProducerService) ByID(ctx context.Context, id ID) (*Producer, error) {
 	return &r.Data, nil
 }
 
-func (s *ProducerService) Search(ctx context.Context, query string, page int) ([]Producer, *Pagination, error) {
-	q := url.Values{"q": {query}, "page": {strconv.Itoa(page)}}
-	var r struct {
-		Data       []Producer `json:"data"`
-		Pagination Pagination `json:"pagination"`
+// ByIDRaw is ByID plus the raw producer JSON.
+func (s *ProducerService) ByIDRaw(ctx context.Context, id ID) (*Producer, json.RawMessage, error) {
+	if err := checkID("producer id", id); err != nil {
+		return nil, nil, err
 	}
-	if err := s.c.Do(ctx, http.MethodGet, "/producers", q, &r); err != nil {
+	r, raw, err := fetchRaw[Producer](ctx, s.c, fmt.Sprintf("/producers/%d", id), nil)
+	if err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
+	return &r, raw, nil
+}
+
+func (s *ProducerService) ByIDs(ctx context.Context, ids []ID, opts BatchOptions) *BatchResult[Producer] {
+	return batch(ctx, ids, opts, s.ByID)
+}
+
+func (s *ProducerService) Full(ctx context.Context, id ID) (*ProducerFull, error) {
+	if err := checkID("producer id", id); err != nil {
+		return nil, err
+	}
+	r, err := fetch[ProducerFull](ctx, s.c, fmt.Sprintf("/producers/%d/full", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (s *ProducerService) External(ctx context.Context, id ID) ([]ExternalLink, error) {
+	if err := checkID("producer id", id); err != nil {
+		return nil, err
+	}
+	return fetch[[]ExternalLink](ctx, s.c, fmt.Sprintf("/producers/%d/external", id), nil)
+}
+
+func (s *ProducerService) Search(ctx context.Context, opts ProducerSearchOptions) ([]Producer, *Pagination, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+	return fetchPaged[[]Producer](ctx, s.c, "/producers", opts.ToValues())
+}
+
+func (s *ProducerService) SearchAll(ctx context.Context, opts ProducerSearchOptions, pageOpts ...PageOption) iter.Seq2[Producer, error] {
+	if err := opts.validate(); err != nil {
+		return failed[Producer](err)
+	}
+	return paginate[Producer](ctx, s.c, "/producers", opts.ToValues(), pageOpts)
 }
@@ -0,0 +1,85 @@
+package jikan
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// Fetch GETs path and decodes the response's data member into T. It is
+// the escape hatch for endpoints or shapes the services do not cover:
+//
+//	type themeSong struct{ Openings []string `json:"openings"` }
+//	songs, err := jikan.Fetch[themeSong](ctx, client, "/anime/1/themes", nil)
+//
+// Requests go through the client's rate limiter, retries, cache and
+// backends like any other.
+func Fetch[T any](ctx context.Context, c *Client, path string, query url.Values) (T, error) {
+	return fetch[T](ctx, c, path, query)
+}
+
+// FetchPaged is Fetch for list endpoints, also returning the pagination.
+func FetchPaged[T any](ctx context.Context, c *Client, path string, query url.Values) (T, *Pagination, error) {
+	return fetchPaged[T](ctx, c, path, query)
+}
+
+// FetchRaw is Fetch that also returns the data member as Jikan sent it,
+// so fields T does not declare can still be read.
+func FetchRaw[T any](ctx context.Context, c *Client, path string, query url.Values) (T, json.RawMessage, error) {
+	return fetchRaw[T](ctx, c, path, query)
+}
+
+// FetchPagedRaw is FetchPaged that also returns the raw data member.
+func FetchPagedRaw[T any](ctx context.Context, c *Client, path string, query url.Values) (T, *Pagination, json.RawMessage, error) {
+	var r struct {
+		Data       T          `json:"data"`
+		Pagination Pagination `json:"pagination"`
+	}
+	raw := &rawResponse{v: &r}
+	if err := c.Do(ctx, http.MethodGet, path, query, raw); err != nil {
+		return r.Data, nil, nil, err
+	}
+	return r.Data, &r.Pagination, raw.data(), nil
+}
+
+func fetchRaw[T any](ctx context.Context, c *Client, path string, query url.Values) (T, json.RawMessage, error) {
+	var r struct {
+		Data T `json:"data"`
+	}
+	raw := &rawResponse{v: &r}
+	if err := c.Do(ctx, http.MethodGet, path, query, raw); err != nil {
+		return r.Data, nil, err
+	}
+	return r.Data, raw.data(), nil
+}
+
+// rawResponse keeps the body it is decoded from next to the decoded value.
+// It marshals back to that body, so cached responses keep every field.
+type rawResponse struct {
+	body json.RawMessage
+	v    any
+}
+
+func (r *rawResponse) UnmarshalJSON(b []byte) error {
+	r.body = append(r.body[:0], b...)
+	return json.Unmarshal(b, r.v)
+}
+
+func (r *rawResponse) MarshalJSON() ([]byte, error) {
+	if r.body == nil {
+		return []byte("null"), nil
+	}
+	return r.body, nil
+}
+
+// data returns the envelope's data member.
+func (r *rawResponse) data() json.RawMessage {
+	var env struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(r.body, &env); err != nil {
+		return nil
+	}
+	return env.Data
+}
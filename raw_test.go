@@ -0,0 +1,37 @@
+package jikan_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Sethispr/jikanGo"
+	"github.com/Sethispr/jikanGo/jikantest"
+)
+
+func TestByIDRawCached(t *testing.T) {
+	c, srv := jikantest.NewClient(t, jikan.WithCache(jikan.NewMemoryCache(), time.Minute))
+	srv.Set("/anime/1", map[string]any{"mal_id": 1, "title": "Cowboy Bebop", "approved": true})
+	ctx := context.Background()
+
+	if _, err := c.Anime.ByID(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	for i := range 2 {
+		a, raw, err := c.Anime.ByIDRaw(ctx, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.Title != "Cowboy Bebop" {
+			t.Errorf("call %d: Title = %q", i, a.Title)
+		}
+		if !bytes.Contains(raw, []byte(`"approved":true`)) {
+			t.Errorf("call %d: raw lost the field the model lacks: %s", i, raw)
+		}
+	}
+	// ByID and the first ByIDRaw each fetch; the second ByIDRaw is cached.
+	if n := len(srv.Requests()); n != 2 {
+		t.Errorf("made %d requests, want 2", n)
+	}
+}
@@ -3,27 +3,47 @@ package jikan
 
 import (
 	"context"
+	"fmt"
+	"iter"
 	"net/http"
-	"net/url"
 	"strconv"
+	"strings"
 )
 
 type RecommendationService struct {
 	c *Client
 }
 
-type Recommendation struct {
-	MalID   string     `json:"mal_id"`
-	Entry   []Resource `json:"entry"`
-	Content string     `json:"content"`
-	Date    string     `json:"date"`
-	User    struct {
-		Username string `json:"username"`
-	} `json:"user"`
+// RecommendationID identifies a user recommendation by the two entries
+// it pairs. Jikan writes it as their MAL IDs joined with "-", e.g. "1-205".
+type RecommendationID struct {
+	From, To ID
+}
+
+func (id RecommendationID) String() string {
+	return id.From.String() + "-" + id.To.String()
+}
+
+func (id RecommendationID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+func (id *RecommendationID) UnmarshalText(b []byte) error {
+	from, to, ok := strings.Cut(string(b), "-")
+	a, errA := strconv.Atoi(from)
+	c, errC := strconv.Atoi(to)
+	if !ok || errA != nil || errC != nil {
+		return fmt.Errorf("jikan: invalid recommendation id %q", b)
+	}
+	*id = RecommendationID{From: ID(a), To: ID(c)}
+	return nil
 }
 
 func (s *RecommendationService) Anime(ctx context.Context, page int) ([]Recommendation, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
+	if err := checkPage(page); err != nil {
+		return nil, nil, err
+	}
+	q := pageQuery(page)
 	var r struct {
 		Data       []Recommendation `json:"data"`
 		Pagination Pagination       `json:"pagination"`
@@ -34,8 +54,15 @@ func (s *RecommendationService) Anime(ctx context.Context, page int) ([]Recommen
 	return r.Data, &r.Pagination, nil
 }
 
+func (s *RecommendationService) AnimeAll(ctx context.Context, pageOpts ...PageOption) iter.Seq2[Recommendation, error] {
+	return paginate[Recommendation](ctx, s.c, "/recommendations/anime", nil, pageOpts)
+}
+
 func (s *RecommendationService) Manga(ctx context.Context, page int) ([]Recommendation, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
+	if err := checkPage(page); err != nil {
+		return nil, nil, err
+	}
+	q := pageQuery(page)
 	var r struct {
 		Data       []Recommendation `json:"data"`
 		Pagination Pagination       `json:"pagination"`
@@ -45,3 +72,7 @@ func (s *RecommendationService) Manga(ctx context.Context, page int) ([]Recommen
 	}
 	return r.Data, &r.Pagination, nil
 }
+
+func (s *RecommendationService) MangaAll(ctx context.Context, pageOpts ...PageOption) iter.Seq2[Recommendation, error] {
+	return paginate[Recommendation](ctx, s.c, "/recommendations/manga", nil, pageOpts)
+}
@@ -4,32 +4,39 @@ package jikan
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
-	"net/url"
-	"strconv"
 )
 
 type ReviewService struct {
 	c *Client
 }
 
+// Review is a review of an anime or a manga. Entry is empty in the lists
+// for one entry, EpisodesWatched is only set for anime and ChaptersRead
+// only for manga.
 type Review struct {
-	MalID     int `json:"mal_id"`
-	Score     int `json:"score"`
-	Reactions struct {
-		Overall int `json:"overall"`
-	} `json:"reactions"`
-	Date      string   `json:"date"`
-	Review    string   `json:"review"`
-	IsSpoiler bool     `json:"is_spoiler"`
-	Entry     Resource `json:"entry"`
-	User      struct {
-		Username string `json:"username"`
-	} `json:"user"`
+	MalID           ID              `json:"mal_id"`
+	URL             string          `json:"url"`
+	Type            string          `json:"type"`
+	Score           int             `json:"score"`
+	Reactions       ReviewReactions `json:"reactions"`
+	Date            Date            `json:"date"`
+	Review          string          `json:"review"`
+	Tags            []string        `json:"tags"`
+	IsSpoiler       bool            `json:"is_spoiler"`
+	IsPreliminary   bool            `json:"is_preliminary"`
+	EpisodesWatched int             `json:"episodes_watched,omitempty"`
+	ChaptersRead    int             `json:"chapters_read,omitempty"`
+	Entry           Entry           `json:"entry,omitempty"`
+	User            UserMeta        `json:"user"`
 }
 
 func (s *ReviewService) Recent(ctx context.Context, page int) ([]Review, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
+	if err := checkPage(page); err != nil {
+		return nil, nil, err
+	}
+	q := pageQuery(page)
 	var r struct {
 		Data       []Review   `json:"data"`
 		Pagination Pagination `json:"pagination"`
@@ -40,8 +47,15 @@ func (s *ReviewService) Recent(ctx context.Context, page int) ([]Review, *Pagina
 	return r.Data, &r.Pagination, nil
 }
 
+func (s *ReviewService) RecentAll(ctx context.Context, pageOpts ...PageOption) iter.Seq2[Review, error] {
+	return paginate[Review](ctx, s.c, "/reviews/recent", nil, pageOpts)
+}
+
 func (s *ReviewService) ForAnime(ctx context.Context, id ID, page int) ([]Review, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
+	if err := validate(checkID("anime id", id), checkPage(page)); err != nil {
+		return nil, nil, err
+	}
+	q := pageQuery(page)
 	var r struct {
 		Data       []Review   `json:"data"`
 		Pagination Pagination `json:"pagination"`
@@ -52,8 +66,18 @@ func (s *ReviewService) ForAnime(ctx context.Context, id ID, page int) ([]Review
 	return r.Data, &r.Pagination, nil
 }
 
+func (s *ReviewService) ForAnimeAll(ctx context.Context, id ID, pageOpts ...PageOption) iter.Seq2[Review, error] {
+	if err := checkID("anime id", id); err != nil {
+		return failed[Review](err)
+	}
+	return paginate[Review](ctx, s.c, fmt.Sprintf("/anime/%d/reviews", id), nil, pageOpts)
+}
+
 func (s *ReviewService) ForManga(ctx context.Context, id ID, page int) ([]Review, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
+	if err := validate(checkID("manga id", id), checkPage(page)); err != nil {
+		return nil, nil, err
+	}
+	q := pageQuery(page)
 	var r struct {
 		Data       []Review   `json:"data"`
 		Pagination Pagination `json:"pagination"`
@@ -63,3 +87,10 @@ func (s *ReviewService) ForManga(ctx context.Context, id ID, page int) ([]Review
 	}
 	return r.Data, &r.Pagination, nil
 }
+
+func (s *ReviewService) ForMangaAll(ctx context.Context, id ID, pageOpts ...PageOption) iter.Seq2[Review, error] {
+	if err := checkID("manga id", id); err != nil {
+		return failed[Review](err)
+	}
+	return paginate[Review](ctx, s.c, fmt.Sprintf("/manga/%d/reviews", id), nil, pageOpts)
+}
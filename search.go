@@ -2,6 +2,7 @@ package jikan
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -9,45 +10,84 @@ import (
 
 type SearchService struct{ c *Client }
 
-func (s *SearchService) Anime(ctx context.Context, query string, opts struct {
-	Type    string
-	Status  string
-	Rating  string
+type AnimeSearchOptions struct {
+	Type    AnimeType
+	Status  AnimeStatus
+	Rating  AnimeRating
 	Genres  []int
-	OrderBy string
-	Sort    string
-	Page    int
-	Limit   int
-}) ([]Anime, *Pagination, error) {
+	OrderBy AnimeOrder
+	Sort    SortDirection
+	// StartDate and EndDate filter by airing dates given as YYYY,
+	// YYYY-MM or YYYY-MM-DD.
+	StartDate string
+	EndDate   string
+	Page      int
+	Limit     int
+}
+
+func (o AnimeSearchOptions) values(query string) url.Values {
 	q := url.Values{}
 	if query != "" {
 		q.Set("q", query)
 	}
-	if opts.Type != "" {
-		q.Set("type", opts.Type)
+	if o.Type != "" {
+		q.Set("type", string(o.Type))
+	}
+	if o.Status != "" {
+		q.Set("status", string(o.Status))
+	}
+	if o.Rating != "" {
+		q.Set("rating", string(o.Rating))
 	}
-	if opts.Status != "" {
-		q.Set("status", opts.Status)
+	if len(o.Genres) > 0 {
+		q.Set("genres", joinInts(o.Genres, ","))
 	}
-	if opts.Rating != "" {
-		q.Set("rating", opts.Rating)
+	if o.OrderBy != "" {
+		q.Set("order_by", string(o.OrderBy))
 	}
-	if len(opts.Genres) > 0 {
-		q.Set("genres", joinInts(opts.Genres, ","))
+	if o.Sort != "" {
+		q.Set("sort", string(o.Sort))
 	}
-	if opts.OrderBy != "" {
-		q.Set("order_by", opts.OrderBy)
+	if o.StartDate != "" {
+		q.Set("start_date", o.StartDate)
 	}
-	if opts.Sort != "" {
-		q.Set("sort", opts.Sort)
+	if o.EndDate != "" {
+		q.Set("end_date", o.EndDate)
 	}
-	if opts.Page > 0 {
-		q.Set("page", strconv.Itoa(opts.Page))
+	if o.Page > 0 {
+		q.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.Limit > 0 {
+		q.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return q
+}
+
+func (o AnimeSearchOptions) validate() error {
+	errs := []error{
+		checkKnown("type", o.Type),
+		checkKnown("status", o.Status),
+		checkKnown("rating", o.Rating),
+		checkEnum("order_by", o.OrderBy, AnimeOrderMalID, AnimeOrderTitle, AnimeOrderStartDate, AnimeOrderEndDate,
+			AnimeOrderEpisodes, AnimeOrderScore, AnimeOrderScoredBy, AnimeOrderRank, AnimeOrderPopularity,
+			AnimeOrderMembers, AnimeOrderFavorites),
+		checkEnum("sort", o.Sort, SortAsc, SortDesc),
+		checkDate("start_date", o.StartDate),
+		checkDate("end_date", o.EndDate),
+		checkPage(o.Page),
+		checkLimit(o.Limit),
 	}
-	if opts.Limit > 0 {
-		q.Set("limit", strconv.Itoa(opts.Limit))
+	for _, g := range o.Genres {
+		errs = append(errs, checkID("genre id", ID(g)))
 	}
+	return validate(errs...)
+}
 
+func (s *SearchService) Anime(ctx context.Context, query string, opts AnimeSearchOptions) ([]Anime, *Pagination, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+	q := opts.values(query)
 	var r struct {
 		Data       []Anime    `json:"data"`
 		Pagination Pagination `json:"pagination"`
@@ -57,3 +97,10 @@ func (s *SearchService) Anime(ctx context.Context, query string, opts struct {
 	}
 	return r.Data, &r.Pagination, nil
 }
+
+func (s *SearchService) AnimeAll(ctx context.Context, query string, opts AnimeSearchOptions, pageOpts ...PageOption) iter.Seq2[Anime, error] {
+	if err := opts.validate(); err != nil {
+		return failed[Anime](err)
+	}
+	return paginate[Anime](ctx, s.c, "/anime", opts.values(query), pageOpts)
+}
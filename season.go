@@ -3,46 +3,150 @@ package jikan
 import (
 	"context"
 	"fmt"
-	"net/http"
+	"iter"
 	"net/url"
 	"strconv"
+	"time"
 )
 
 type SeasonService struct{ c *Client }
 
-func (s *SeasonService) Now(ctx context.Context, page int) ([]Anime, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
-	var r struct {
-		Data       []Anime    `json:"data"`
-		Pagination Pagination `json:"pagination"`
+type Season string
+
+const (
+	SeasonWinter Season = "winter"
+	SeasonSpring Season = "spring"
+	SeasonSummer Season = "summer"
+	SeasonFall   Season = "fall"
+)
+
+var seasonOrder = [...]Season{SeasonWinter, SeasonSpring, SeasonSummer, SeasonFall}
+
+func (s Season) String() string { return string(s) }
+
+// Valid reports whether s is one of the four seasons Jikan accepts.
+func (s Season) Valid() bool {
+	switch s {
+	case SeasonWinter, SeasonSpring, SeasonSummer, SeasonFall:
+		return true
+	}
+	return false
+}
+
+// CurrentSeason returns the year and season t falls in. MAL seasons are
+// calendar quarters: winter starts in January, spring in April and so on.
+func CurrentSeason(t time.Time) (int, Season) {
+	return t.Year(), seasonOrder[(int(t.Month())-1)/3]
+}
+
+// NextSeason returns the year and season following the one t falls in.
+func NextSeason(t time.Time) (int, Season) {
+	i := (int(t.Month()) - 1) / 3
+	if i == len(seasonOrder)-1 {
+		return t.Year() + 1, seasonOrder[0]
+	}
+	return t.Year(), seasonOrder[i+1]
+}
+
+// SeasonArchive lists the seasons available for a given year.
+type SeasonArchive struct {
+	Year    int      `json:"year"`
+	Seasons []Season `json:"seasons"`
+}
+
+type SeasonOptions struct {
+	Filter     AnimeType
+	SFW        bool
+	Unapproved bool
+	Continuing bool
+	Page       int
+	Limit      int
+}
+
+func (o SeasonOptions) ToValues() url.Values {
+	v := url.Values{}
+	if o.Filter != "" {
+		v.Set("filter", string(o.Filter))
+	}
+	if o.SFW {
+		v.Set("sfw", "true")
+	}
+	if o.Unapproved {
+		v.Set("unapproved", "true")
+	}
+	if o.Continuing {
+		v.Set("continuing", "true")
+	}
+	if o.Page > 0 {
+		v.Set("page", strconv.Itoa(o.Page))
 	}
-	if err := s.c.Do(ctx, http.MethodGet, "/seasons/now", q, &r); err != nil {
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return v
+}
+
+func checkYear(year int) error {
+	if year < 1 {
+		return invalid("year", year, "must be positive")
+	}
+	return nil
+}
+
+func checkSeason(season Season) error {
+	if !season.Valid() {
+		return invalid("season", string(season), "must be winter, spring, summer or fall")
+	}
+	return nil
+}
+
+func (o SeasonOptions) validate() error {
+	return validate(checkKnown("filter", o.Filter), checkPage(o.Page), checkLimit(o.Limit))
+}
+
+// List returns every year and season Jikan has an archive for.
+func (s *SeasonService) List(ctx context.Context) ([]SeasonArchive, error) {
+	return fetch[[]SeasonArchive](ctx, s.c, "/seasons", nil)
+}
+
+func (s *SeasonService) Now(ctx context.Context, opts SeasonOptions) ([]Anime, *Pagination, error) {
+	if err := opts.validate(); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
+	return fetchPaged[[]Anime](ctx, s.c, "/seasons/now", opts.ToValues())
 }
 
-func (s *SeasonService) Archive(ctx context.Context, year int, season string, page int) ([]Anime, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
-	var r struct {
-		Data       []Anime    `json:"data"`
-		Pagination Pagination `json:"pagination"`
+func (s *SeasonService) NowAll(ctx context.Context, opts SeasonOptions, pageOpts ...PageOption) iter.Seq2[Anime, error] {
+	if err := opts.validate(); err != nil {
+		return failed[Anime](err)
 	}
-	path := fmt.Sprintf("/seasons/%d/%s", year, season)
-	if err := s.c.Do(ctx, http.MethodGet, path, q, &r); err != nil {
+	return paginate[Anime](ctx, s.c, "/seasons/now", opts.ToValues(), pageOpts)
+}
+
+func (s *SeasonService) Archive(ctx context.Context, year int, season Season, opts SeasonOptions) ([]Anime, *Pagination, error) {
+	if err := validate(checkYear(year), checkSeason(season), opts.validate()); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
+	return fetchPaged[[]Anime](ctx, s.c, fmt.Sprintf("/seasons/%d/%s", year, season), opts.ToValues())
 }
 
-func (s *SeasonService) Upcoming(ctx context.Context, page int) ([]Anime, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
-	var r struct {
-		Data       []Anime    `json:"data"`
-		Pagination Pagination `json:"pagination"`
+func (s *SeasonService) ArchiveAll(ctx context.Context, year int, season Season, opts SeasonOptions, pageOpts ...PageOption) iter.Seq2[Anime, error] {
+	if err := validate(checkYear(year), checkSeason(season), opts.validate()); err != nil {
+		return failed[Anime](err)
 	}
-	if err := s.c.Do(ctx, http.MethodGet, "/seasons/upcoming", q, &r); err != nil {
+	return paginate[Anime](ctx, s.c, fmt.Sprintf("/seasons/%d/%s", year, season), opts.ToValues(), pageOpts)
+}
+
+func (s *SeasonService) Upcoming(ctx context.Context, opts SeasonOptions) ([]Anime, *Pagination, error) {
+	if err := opts.validate(); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
+	return fetchPaged[[]Anime](ctx, s.c, "/seasons/upcoming", opts.ToValues())
+}
+
+func (s *SeasonService) UpcomingAll(ctx context.Context, opts SeasonOptions, pageOpts ...PageOption) iter.Seq2[Anime, error] {
+	if err := opts.validate(); err != nil {
+		return failed[Anime](err)
+	}
+	return paginate[Anime](ctx, s.c, "/seasons/upcoming", opts.ToValues(), pageOpts)
 }
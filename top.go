@@ -3,7 +3,7 @@ package jikan
 
 import (
 	"context"
-	"net/http"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -12,76 +12,197 @@ type TopService struct {
 	c *Client
 }
 
-func (s *TopService) Anime(ctx context.Context, filter string, page int) ([]Anime, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
-	if filter != "" {
-		q.Set("type", filter)
+type TopAnimeFilter string
+
+const (
+	TopAnimeAiring       TopAnimeFilter = "airing"
+	TopAnimeUpcoming     TopAnimeFilter = "upcoming"
+	TopAnimeByPopularity TopAnimeFilter = "bypopularity"
+	TopAnimeFavorite     TopAnimeFilter = "favorite"
+)
+
+type TopMangaFilter string
+
+const (
+	TopMangaPublishing   TopMangaFilter = "publishing"
+	TopMangaUpcoming     TopMangaFilter = "upcoming"
+	TopMangaByPopularity TopMangaFilter = "bypopularity"
+	TopMangaFavorite     TopMangaFilter = "favorite"
+)
+
+type TopAnimeOptions struct {
+	Type   AnimeType
+	Filter TopAnimeFilter
+	Rating AnimeRating
+	SFW    bool
+	Page   int
+	Limit  int
+}
+
+func (o TopAnimeOptions) ToValues() url.Values {
+	v := url.Values{}
+	if o.Type != "" {
+		v.Set("type", string(o.Type))
+	}
+	if o.Filter != "" {
+		v.Set("filter", string(o.Filter))
+	}
+	if o.Rating != "" {
+		v.Set("rating", string(o.Rating))
+	}
+	if o.SFW {
+		v.Set("sfw", "true")
+	}
+	if o.Page > 0 {
+		v.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return v
+}
+
+func (o TopAnimeOptions) validate() error {
+	return validate(
+		checkKnown("type", o.Type),
+		checkEnum("filter", o.Filter, TopAnimeAiring, TopAnimeUpcoming, TopAnimeByPopularity, TopAnimeFavorite),
+		checkKnown("rating", o.Rating),
+		checkPage(o.Page),
+		checkLimit(o.Limit),
+	)
+}
+
+type TopMangaOptions struct {
+	Type   MangaType
+	Filter TopMangaFilter
+	Page   int
+	Limit  int
+}
+
+func (o TopMangaOptions) ToValues() url.Values {
+	v := url.Values{}
+	if o.Type != "" {
+		v.Set("type", string(o.Type))
+	}
+	if o.Filter != "" {
+		v.Set("filter", string(o.Filter))
+	}
+	if o.Page > 0 {
+		v.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return v
+}
+
+func (o TopMangaOptions) validate() error {
+	return validate(
+		checkKnown("type", o.Type),
+		checkEnum("filter", o.Filter, TopMangaPublishing, TopMangaUpcoming, TopMangaByPopularity, TopMangaFavorite),
+		checkPage(o.Page),
+		checkLimit(o.Limit),
+	)
+}
+
+type ReviewType string
+
+const (
+	ReviewTypeAnime ReviewType = "anime"
+	ReviewTypeManga ReviewType = "manga"
+)
+
+type TopReviewsOptions struct {
+	Type        ReviewType
+	Preliminary bool
+	Spoilers    bool
+	Page        int
+}
+
+func (o TopReviewsOptions) ToValues() url.Values {
+	v := url.Values{}
+	if o.Type != "" {
+		v.Set("type", string(o.Type))
 	}
-	var r struct {
-		Data       []Anime    `json:"data"`
-		Pagination Pagination `json:"pagination"`
+	if o.Preliminary {
+		v.Set("preliminary", "true")
 	}
-	if err := s.c.Do(ctx, http.MethodGet, "/top/anime", q, &r); err != nil {
+	if o.Spoilers {
+		v.Set("spoilers", "true")
+	}
+	if o.Page > 0 {
+		v.Set("page", strconv.Itoa(o.Page))
+	}
+	return v
+}
+
+func (o TopReviewsOptions) validate() error {
+	return validate(checkEnum("type", o.Type, ReviewTypeAnime, ReviewTypeManga), checkPage(o.Page))
+}
+
+func (s *TopService) Anime(ctx context.Context, opts TopAnimeOptions) ([]Anime, *Pagination, error) {
+	if err := opts.validate(); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
+	return fetchPaged[[]Anime](ctx, s.c, "/top/anime", opts.ToValues())
 }
 
-func (s *TopService) Manga(ctx context.Context, filter string, page int) ([]Manga, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
-	if filter != "" {
-		q.Set("type", filter)
+func (s *TopService) AnimeAll(ctx context.Context, opts TopAnimeOptions, pageOpts ...PageOption) iter.Seq2[Anime, error] {
+	if err := opts.validate(); err != nil {
+		return failed[Anime](err)
 	}
-	var r struct {
-		Data       []Manga    `json:"data"`
-		Pagination Pagination `json:"pagination"`
+	return paginate[Anime](ctx, s.c, "/top/anime", opts.ToValues(), pageOpts)
+}
+
+func (s *TopService) Manga(ctx context.Context, opts TopMangaOptions) ([]Manga, *Pagination, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
 	}
-	if err := s.c.Do(ctx, http.MethodGet, "/top/manga", q, &r); err != nil {
+	return fetchPaged[[]Manga](ctx, s.c, "/top/manga", opts.ToValues())
+}
+
+func (s *TopService) MangaAll(ctx context.Context, opts TopMangaOptions, pageOpts ...PageOption) iter.Seq2[Manga, error] {
+	if err := opts.validate(); err != nil {
+		return failed[Manga](err)
+	}
+	return paginate[Manga](ctx, s.c, "/top/manga", opts.ToValues(), pageOpts)
+}
+
+func (s *TopService) People(ctx context.Context, page int) ([]Person, *Pagination, error) {
+	if err := checkPage(page); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
-}
-
-func (s *TopService) People(ctx context.Context, page int) ([]struct {
-	MalID     ID       `json:"mal_id"`
-	Name      string   `json:"name"`
-	Images    ImageSet `json:"images"`
-	Favorites int      `json:"favorites"`
-}, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
-	var r struct {
-		Data []struct {
-			MalID     ID       `json:"mal_id"`
-			Name      string   `json:"name"`
-			Images    ImageSet `json:"images"`
-			Favorites int      `json:"favorites"`
-		} `json:"data"`
-		Pagination Pagination `json:"pagination"`
-	}
-	if err := s.c.Do(ctx, http.MethodGet, "/top/people", q, &r); err != nil {
+	q := pageQuery(page)
+	return fetchPaged[[]Person](ctx, s.c, "/top/people", q)
+}
+
+func (s *TopService) PeopleAll(ctx context.Context, pageOpts ...PageOption) iter.Seq2[Person, error] {
+	return paginate[Person](ctx, s.c, "/top/people", nil, pageOpts)
+}
+
+func (s *TopService) Characters(ctx context.Context, page int) ([]Character, *Pagination, error) {
+	if err := checkPage(page); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
-}
-
-func (s *TopService) Characters(ctx context.Context, page int) ([]struct {
-	MalID     ID       `json:"mal_id"`
-	Name      string   `json:"name"`
-	Images    ImageSet `json:"images"`
-	Favorites int      `json:"favorites"`
-}, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
-	var r struct {
-		Data []struct {
-			MalID     ID       `json:"mal_id"`
-			Name      string   `json:"name"`
-			Images    ImageSet `json:"images"`
-			Favorites int      `json:"favorites"`
-		} `json:"data"`
-		Pagination Pagination `json:"pagination"`
-	}
-	if err := s.c.Do(ctx, http.MethodGet, "/top/characters", q, &r); err != nil {
+	q := pageQuery(page)
+	return fetchPaged[[]Character](ctx, s.c, "/top/characters", q)
+}
+
+func (s *TopService) CharactersAll(ctx context.Context, pageOpts ...PageOption) iter.Seq2[Character, error] {
+	return paginate[Character](ctx, s.c, "/top/characters", nil, pageOpts)
+}
+
+// Reviews returns the most helpful reviews across anime and manga.
+func (s *TopService) Reviews(ctx context.Context, opts TopReviewsOptions) ([]Review, *Pagination, error) {
+	if err := opts.validate(); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
+	return fetchPaged[[]Review](ctx, s.c, "/top/reviews", opts.ToValues())
+}
+
+func (s *TopService) ReviewsAll(ctx context.Context, opts TopReviewsOptions, pageOpts ...PageOption) iter.Seq2[Review, error] {
+	if err := opts.validate(); err != nil {
+		return failed[Review](err)
+	}
+	return paginate[Review](ctx, s.c, "/top/reviews", opts.ToValues(), pageOpts)
 }
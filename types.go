@@ -1,5 +1,7 @@
 package jikan
 
+//go:generate go run ./internal/cmd/genmodels
+
 import "strconv"
 
 type ID int
@@ -13,15 +15,19 @@ type Resource struct {
 	URL   string `json:"url"`
 }
 
+// ImageURL and ImageSet hold an entity's images. Jikan sends every size
+// for anime and manga, but only image_url, or image_url and
+// small_image_url, for characters, people, producers and news, and no
+// WebP set for some of them.
 type ImageURL struct {
-	Large  string `json:"large_image_url"`
+	Large  string `json:"large_image_url,omitempty"`
 	Medium string `json:"image_url"`
-	Small  string `json:"small_image_url"`
+	Small  string `json:"small_image_url,omitempty"`
 }
 
 type ImageSet struct {
 	JPG  ImageURL `json:"jpg"`
-	WebP ImageURL `json:"webp"`
+	WebP ImageURL `json:"webp,omitempty"`
 }
 
 type Title struct {
@@ -29,21 +35,135 @@ type Title struct {
 	Title    string `json:"title"`
 }
 
-type DateRange struct {
-	From string `json:"from"`
-	To   string `json:"to"`
+// Pagination describes a page of a list. Lists nested under an entity,
+// such as /anime/{id}/news, only send LastPage and HasNext.
+type Pagination struct {
+	LastPage    int             `json:"last_visible_page"`
+	CurrentPage int             `json:"current_page,omitempty"`
+	HasNext     bool            `json:"has_next_page"`
+	Total       int             `json:"-"`
+	Items       PaginationItems `json:"items,omitempty"`
 }
 
-type Pagination struct {
-	LastPage    int  `json:"last_visible_page"`
-	CurrentPage int  `json:"current_page"`
-	HasNext     bool `json:"has_next_page"`
-	Total       int  `json:"-"`
+type PaginationItems struct {
+	Count   int `json:"count"`
+	Total   int `json:"total"`
+	PerPage int `json:"per_page"`
+}
+
+type ScoreStats struct {
+	Score      int     `json:"score"`
+	Votes      int     `json:"votes"`
+	Percentage float64 `json:"percentage"`
+}
+
+// BasicImage and BasicImageSet cover images that only come in one size,
+// such as user avatars, club banners and episode thumbnails. Clubs and
+// episodes only have the JPG one.
+type BasicImage struct {
+	ImageURL string `json:"image_url"`
+}
+
+type BasicImageSet struct {
+	JPG  BasicImage `json:"jpg"`
+	WebP BasicImage `json:"webp,omitempty"`
+}
+
+// TrailerImages holds the YouTube thumbnails of a trailer. Jikan sends
+// the default one as image_url; DefaultImageURL is kept for code written
+// against earlier versions of AnimeService.Videos.
+type TrailerImages struct {
+	ImageURL        string `json:"image_url"`
+	DefaultImageURL string `json:"default_image_url,omitempty"`
+	SmallImageURL   string `json:"small_image_url"`
+	MediumImageURL  string `json:"medium_image_url"`
+	LargeImageURL   string `json:"large_image_url"`
+	MaximumImageURL string `json:"maximum_image_url"`
+}
+
+type Trailer struct {
+	YoutubeID string        `json:"youtube_id"`
+	URL       string        `json:"url"`
+	EmbedURL  string        `json:"embed_url"`
+	Images    TrailerImages `json:"images"`
+}
+
+type ExternalLink struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// UserMeta, PersonMeta and CharacterMeta are the short forms Jikan nests
+// inside other payloads.
+type UserMeta struct {
+	Username string        `json:"username"`
+	URL      string        `json:"url"`
+	Images   BasicImageSet `json:"images"`
+}
+
+type PersonMeta struct {
+	MalID  ID       `json:"mal_id"`
+	URL    string   `json:"url"`
+	Images ImageSet `json:"images"`
+	Name   string   `json:"name"`
+}
+
+type CharacterMeta struct {
+	MalID  ID       `json:"mal_id"`
+	URL    string   `json:"url"`
+	Images ImageSet `json:"images"`
+	Name   string   `json:"name"`
+}
+
+type NewsArticle struct {
+	MalID          ID       `json:"mal_id"`
+	URL            string   `json:"url"`
+	Title          string   `json:"title"`
+	Date           Date     `json:"date"`
+	AuthorUsername string   `json:"author_username"`
+	AuthorURL      string   `json:"author_url"`
+	ForumURL       string   `json:"forum_url"`
+	Images         ImageSet `json:"images"`
+	Comments       int      `json:"comments"`
+	Excerpt        string   `json:"excerpt"`
+}
+
+type ForumComment struct {
+	URL            string `json:"url"`
+	AuthorUsername string `json:"author_username"`
+	AuthorURL      string `json:"author_url"`
+	Date           Date   `json:"date"`
+}
+
+type ForumTopic struct {
+	MalID          ID           `json:"mal_id"`
+	URL            string       `json:"url"`
+	Title          string       `json:"title"`
+	Date           Date         `json:"date"`
+	AuthorUsername string       `json:"author_username"`
+	AuthorURL      string       `json:"author_url"`
+	Comments       int          `json:"comments"`
+	LastComment    ForumComment `json:"last_comment"`
+}
+
+type Relation struct {
+	Relation string     `json:"relation"`
+	Entry    []Resource `json:"entry"`
+}
+
+type EntryRecommendation struct {
+	Entry Entry  `json:"entry"`
+	URL   string `json:"url"`
+	Votes int    `json:"votes"`
 }
 
-type Statistics struct {
-	Days      float64 `json:"days_watched"`
-	MeanScore float64 `json:"mean_score"`
-	Completed int     `json:"completed"`
-	Total     int     `json:"total_entries"`
+type ReviewReactions struct {
+	Overall     int `json:"overall"`
+	Nice        int `json:"nice"`
+	LoveIt      int `json:"love_it"`
+	Funny       int `json:"funny"`
+	Confusing   int `json:"confusing"`
+	Informative int `json:"informative"`
+	WellWritten int `json:"well_written"`
+	Creative    int `json:"creative"`
 }
@@ -2,8 +2,9 @@ package jikan
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"net/http"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -13,50 +14,47 @@ type UserService struct {
 }
 
 type User struct {
-	MalID    ID     `json:"mal_id"`
-	Username string `json:"username"`
-	URL      string `json:"url"`
-	Images   struct {
-		JPG struct {
-			ImageURL string `json:"image_url"`
-		} `json:"jpg"`
-		WebP struct {
-			ImageURL string `json:"image_url"`
-		} `json:"webp"`
-	} `json:"images"`
-	LastOnline string `json:"last_online"`
-	Gender     string `json:"gender"`
-	Birthday   string `json:"birthday"`
-	Location   string `json:"location"`
-	Joined     string `json:"joined"`
+	MalID      ID            `json:"mal_id"`
+	Username   string        `json:"username"`
+	URL        string        `json:"url"`
+	Images     BasicImageSet `json:"images"`
+	LastOnline Date          `json:"last_online"`
+	Gender     string        `json:"gender"`
+	Birthday   Date          `json:"birthday"`
+	Location   string        `json:"location"`
+	Joined     Date          `json:"joined"`
 }
 
 type UserStatistics struct {
-	Anime struct {
-		DaysWatched     float64 `json:"days_watched"`
-		MeanScore       float64 `json:"mean_score"`
-		Watching        int     `json:"watching"`
-		Completed       int     `json:"completed"`
-		OnHold          int     `json:"on_hold"`
-		Dropped         int     `json:"dropped"`
-		PlanToWatch     int     `json:"plan_to_watch"`
-		TotalEntries    int     `json:"total_entries"`
-		Rewatched       int     `json:"rewatched"`
-		EpisodesWatched int     `json:"episodes_watched"`
-	} `json:"anime"`
-	Manga struct {
-		DaysRead     float64 `json:"days_read"`
-		MeanScore    float64 `json:"mean_score"`
-		Reading      int     `json:"reading"`
-		Completed    int     `json:"completed"`
-		OnHold       int     `json:"on_hold"`
-		Dropped      int     `json:"dropped"`
-		PlanToRead   int     `json:"plan_to_read"`
-		TotalEntries int     `json:"total_entries"`
-		Reread       int     `json:"reread"`
-		ChaptersRead int     `json:"chapters_read"`
-		VolumesRead  int     `json:"volumes_read"`
-	} `json:"manga"`
+	Anime UserAnimeStats `json:"anime"`
+	Manga UserMangaStats `json:"manga"`
+}
+
+type UserAnimeStats struct {
+	DaysWatched     Optional[float64] `json:"days_watched"`
+	MeanScore       Optional[float64] `json:"mean_score"`
+	Watching        int               `json:"watching"`
+	Completed       int               `json:"completed"`
+	OnHold          int               `json:"on_hold"`
+	Dropped         int               `json:"dropped"`
+	PlanToWatch     int               `json:"plan_to_watch"`
+	TotalEntries    int               `json:"total_entries"`
+	Rewatched       int               `json:"rewatched"`
+	EpisodesWatched int               `json:"episodes_watched"`
+}
+
+type UserMangaStats struct {
+	DaysRead     Optional[float64] `json:"days_read"`
+	MeanScore    Optional[float64] `json:"mean_score"`
+	Reading      int               `json:"reading"`
+	Completed    int               `json:"completed"`
+	OnHold       int               `json:"on_hold"`
+	Dropped      int               `json:"dropped"`
+	PlanToRead   int               `json:"plan_to_read"`
+	TotalEntries int               `json:"total_entries"`
+	Reread       int               `json:"reread"`
+	ChaptersRead int               `json:"chapters_read"`
+	VolumesRead  int               `json:"volumes_read"`
 }
 
 type UserFavoriteEntry struct {
@@ -82,186 +80,334 @@ type UserFavoritePerson struct {
 	Name   string   `json:"name"`
 }
 
+type UserFavorites struct {
+	Anime      []UserFavoriteEntry  `json:"anime"`
+	Manga      []UserFavoriteEntry  `json:"manga"`
+	Characters []UserFavoriteChar   `json:"characters"`
+	People     []UserFavoritePerson `json:"people"`
+}
+
+type UserFull struct {
+	User
+	Statistics UserStatistics `json:"statistics"`
+	External   []ExternalLink `json:"external"`
+}
+
+type UserSearchResult struct {
+	URL        string        `json:"url"`
+	Username   string        `json:"username"`
+	Images     BasicImageSet `json:"images"`
+	LastOnline Date          `json:"last_online"`
+}
+
+type UserRef struct {
+	URL      string `json:"url"`
+	Username string `json:"username"`
+}
+
+type UserHistory struct {
+	Entry     Resource `json:"entry"`
+	Increment int      `json:"increment"`
+	Date      Date     `json:"date"`
+}
+
+type UserFriend struct {
+	User         UserMeta `json:"user"`
+	LastOnline   Date     `json:"last_online"`
+	FriendsSince Date     `json:"friends_since"`
+}
+
+// UserReview is a review in a user's profile, shaped like the ones from
+// ReviewService.
+type UserReview = Review
+
+type UserClub struct {
+	MalID ID     `json:"mal_id"`
+	Name  string `json:"name"`
+	URL   string `json:"url"`
+}
+
+type UserAnimeUpdate struct {
+	Entry         Entry         `json:"entry"`
+	Score         Optional[int] `json:"score"`
+	Status        string        `json:"status"`
+	EpisodesSeen  Optional[int] `json:"episodes_seen"`
+	EpisodesTotal Optional[int] `json:"episodes_total"`
+	Date          Date          `json:"date"`
+}
+
+type UserMangaUpdate struct {
+	Entry         Entry         `json:"entry"`
+	Score         Optional[int] `json:"score"`
+	Status        string        `json:"status"`
+	ChaptersRead  Optional[int] `json:"chapters_read"`
+	ChaptersTotal Optional[int] `json:"chapters_total"`
+	VolumesRead   Optional[int] `json:"volumes_read"`
+	VolumesTotal  Optional[int] `json:"volumes_total"`
+	Date          Date          `json:"date"`
+}
+
+type UserUpdates struct {
+	Anime []UserAnimeUpdate `json:"anime"`
+	Manga []UserMangaUpdate `json:"manga"`
+}
+
+type UserGender string
+
+const (
+	UserGenderAny       UserGender = "any"
+	UserGenderMale      UserGender = "male"
+	UserGenderFemale    UserGender = "female"
+	UserGenderNonBinary UserGender = "nonbinary"
+)
+
+type UserSearchOptions struct {
+	Query    string
+	Gender   UserGender
+	Location string
+	MinAge   int
+	MaxAge   int
+	Page     int
+	Limit    int
+}
+
+func (o UserSearchOptions) ToValues() url.Values {
+	v := url.Values{}
+	if o.Query != "" {
+		v.Set("q", o.Query)
+	}
+	if o.Gender != "" {
+		v.Set("gender", string(o.Gender))
+	}
+	if o.Location != "" {
+		v.Set("location", o.Location)
+	}
+	if o.MinAge > 0 {
+		v.Set("minAge", strconv.Itoa(o.MinAge))
+	}
+	if o.MaxAge > 0 {
+		v.Set("maxAge", strconv.Itoa(o.MaxAge))
+	}
+	if o.Page > 0 {
+		v.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return v
+}
+
+func (o UserSearchOptions) validate() error {
+	errs := []error{
+		checkEnum("gender", o.Gender, UserGenderAny, UserGenderMale, UserGenderFemale, UserGenderNonBinary),
+		checkPage(o.Page),
+		checkLimit(o.Limit),
+	}
+	if o.MinAge < 0 {
+		errs = append(errs, invalid("minAge", o.MinAge, "must not be negative"))
+	}
+	if o.MaxAge < 0 {
+		errs = append(errs, invalid("maxAge", o.MaxAge, "must not be negative"))
+	} else if o.MaxAge > 0 && o.MaxAge < o.MinAge {
+		errs = append(errs, invalid("maxAge", o.MaxAge, "must not be below minAge"))
+	}
+	return validate(errs...)
+}
+
+// ByID returns the basic profile for username from /users/{username}.
+// Earlier versions requested /users/{username}/full here; use Full for
+// the statistics and external links that route adds.
 func (s *UserService) ByID(ctx context.Context, username string) (*User, error) {
-	var r struct{ Data User }
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/users/%s/full", username), nil, &r); err != nil {
+	if err := checkUsername(username); err != nil {
+		return nil, err
+	}
+	r, err := fetch[User](ctx, s.c, userPath(username), nil)
+	if err != nil {
 		return nil, err
 	}
-	return &r.Data, nil
+	return &r, nil
+}
+
+// ByIDRaw is ByID plus the profile JSON as Jikan sent it.
+func (s *UserService) ByIDRaw(ctx context.Context, username string) (*User, json.RawMessage, error) {
+	if err := checkUsername(username); err != nil {
+		return nil, nil, err
+	}
+	r, raw, err := fetchRaw[User](ctx, s.c, userPath(username), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &r, raw, nil
+}
+
+// Full returns the profile together with statistics and external links.
+func (s *UserService) Full(ctx context.Context, username string) (*UserFull, error) {
+	if err := checkUsername(username); err != nil {
+		return nil, err
+	}
+	r, err := fetch[UserFull](ctx, s.c, userPath(username, "full"), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ByMalID resolves a numeric MAL user ID to its username and profile URL.
+func (s *UserService) ByMalID(ctx context.Context, id ID) (*UserRef, error) {
+	if err := checkID("user id", id); err != nil {
+		return nil, err
+	}
+	r, err := fetch[UserRef](ctx, s.c, fmt.Sprintf("/users/userbyid/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (s *UserService) Search(ctx context.Context, opts UserSearchOptions) ([]UserSearchResult, *Pagination, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+	return fetchPaged[[]UserSearchResult](ctx, s.c, "/users", opts.ToValues())
+}
+
+func (s *UserService) SearchAll(ctx context.Context, opts UserSearchOptions, pageOpts ...PageOption) iter.Seq2[UserSearchResult, error] {
+	if err := opts.validate(); err != nil {
+		return failed[UserSearchResult](err)
+	}
+	return paginate[UserSearchResult](ctx, s.c, "/users", opts.ToValues(), pageOpts)
 }
 
 func (s *UserService) Statistics(ctx context.Context, username string) (*UserStatistics, error) {
-	var r struct{ Data UserStatistics }
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/users/%s/statistics", username), nil, &r); err != nil {
+	if err := checkUsername(username); err != nil {
+		return nil, err
+	}
+	r, err := fetch[UserStatistics](ctx, s.c, userPath(username, "statistics"), nil)
+	if err != nil {
 		return nil, err
 	}
-	return &r.Data, nil
+	return &r, nil
 }
 
 func (s *UserService) About(ctx context.Context, username string) (string, error) {
-	var r struct {
-		Data struct {
-			About string `json:"about"`
-		} `json:"data"`
-	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/users/%s/about", username), nil, &r); err != nil {
+	if err := checkUsername(username); err != nil {
 		return "", err
 	}
-	return r.Data.About, nil
+	type about struct {
+		About string `json:"about"`
+	}
+	r, err := fetch[about](ctx, s.c, userPath(username, "about"), nil)
+	return r.About, err
 }
 
-func (s *UserService) History(ctx context.Context, username string, filter string, page int) ([]struct {
-	Entry     Resource `json:"entry"`
-	Increment int      `json:"increment"`
-	Date      string   `json:"date"`
-	Score     *float64 `json:"score"`
-}, *Pagination, error) {
-
-	q := url.Values{"page": {strconv.Itoa(page)}}
+func (s *UserService) History(ctx context.Context, username string, filter string, page int) ([]UserHistory, *Pagination, error) {
+	if err := validate(checkUsername(username), checkPage(page)); err != nil {
+		return nil, nil, err
+	}
+	q := pageQuery(page)
 	if filter != "" {
 		q.Set("filter", filter)
 	}
+	return fetchPaged[[]UserHistory](ctx, s.c, userPath(username, "history"), q)
+}
 
-	var r struct {
-		Data []struct {
-			Entry     Resource `json:"entry"`
-			Increment int      `json:"increment"`
-			Date      string   `json:"date"`
-			Score     *float64 `json:"score"`
-		} `json:"data"`
-		Pagination Pagination `json:"pagination"`
+func (s *UserService) HistoryAll(ctx context.Context, username string, filter string, pageOpts ...PageOption) iter.Seq2[UserHistory, error] {
+	if err := checkUsername(username); err != nil {
+		return failed[UserHistory](err)
+	}
+	q := url.Values{}
+	if filter != "" {
+		q.Set("filter", filter)
 	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/users/%s/history", username), q, &r); err != nil {
+	return paginate[UserHistory](ctx, s.c, userPath(username, "history"), q, pageOpts)
+}
+
+func (s *UserService) Friends(ctx context.Context, username string, page int) ([]UserFriend, *Pagination, error) {
+	if err := validate(checkUsername(username), checkPage(page)); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
+	q := pageQuery(page)
+	return fetchPaged[[]UserFriend](ctx, s.c, userPath(username, "friends"), q)
 }
 
-func (s *UserService) Friends(ctx context.Context, username string, page int) ([]struct {
-	User         Resource `json:"user"`
-	LastOnline   string   `json:"last_online"`
-	FriendsSince string   `json:"friends_since"`
-}, *Pagination, error) {
+func (s *UserService) FriendsAll(ctx context.Context, username string, pageOpts ...PageOption) iter.Seq2[UserFriend, error] {
+	if err := checkUsername(username); err != nil {
+		return failed[UserFriend](err)
+	}
+	return paginate[UserFriend](ctx, s.c, userPath(username, "friends"), nil, pageOpts)
+}
 
-	q := url.Values{"page": {strconv.Itoa(page)}}
-	var r struct {
-		Data []struct {
-			User         Resource `json:"user"`
-			LastOnline   string   `json:"last_online"`
-			FriendsSince string   `json:"friends_since"`
-		} `json:"data"`
-		Pagination Pagination `json:"pagination"`
+func (s *UserService) Favorites(ctx context.Context, username string) (*UserFavorites, error) {
+	if err := checkUsername(username); err != nil {
+		return nil, err
 	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/users/%s/friends", username), q, &r); err != nil {
-		return nil, nil, err
+	r, err := fetch[UserFavorites](ctx, s.c, userPath(username, "favorites"), nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.Data, &r.Pagination, nil
+	return &r, nil
 }
 
-func (s *UserService) Favorites(ctx context.Context, username string) (*struct {
-	Anime      []UserFavoriteEntry  `json:"anime"`
-	Manga      []UserFavoriteEntry  `json:"manga"`
-	Characters []UserFavoriteChar   `json:"characters"`
-	People     []UserFavoritePerson `json:"people"`
-}, error) {
-
-	var r struct {
-		Data struct {
-			Anime      []UserFavoriteEntry  `json:"anime"`
-			Manga      []UserFavoriteEntry  `json:"manga"`
-			Characters []UserFavoriteChar   `json:"characters"`
-			People     []UserFavoritePerson `json:"people"`
-		} `json:"data"`
+// Updates returns the user's most recent anime and manga list updates.
+func (s *UserService) Updates(ctx context.Context, username string) (*UserUpdates, error) {
+	if err := checkUsername(username); err != nil {
+		return nil, err
 	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/users/%s/favorites", username), nil, &r); err != nil {
+	r, err := fetch[UserUpdates](ctx, s.c, userPath(username, "userupdates"), nil)
+	if err != nil {
 		return nil, err
 	}
-	return &r.Data, nil
-}
-
-func (s *UserService) Reviews(ctx context.Context, username string, page int) ([]struct {
-	MalID  int      `json:"mal_id"`
-	Entry  Resource `json:"entry"`
-	Score  int      `json:"score"`
-	Review string   `json:"review"`
-	Date   string   `json:"date"`
-	Votes  int      `json:"votes"`
-}, *Pagination, error) {
-
-	q := url.Values{"page": {strconv.Itoa(page)}}
-	var r struct {
-		Data []struct {
-			MalID  int      `json:"mal_id"`
-			Entry  Resource `json:"entry"`
-			Score  int      `json:"score"`
-			Review string   `json:"review"`
-			Date   string   `json:"date"`
-			Votes  int      `json:"votes"`
-		} `json:"data"`
-		Pagination Pagination `json:"pagination"`
-	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/users/%s/reviews", username), q, &r); err != nil {
+	return &r, nil
+}
+
+func (s *UserService) Reviews(ctx context.Context, username string, page int) ([]UserReview, *Pagination, error) {
+	if err := validate(checkUsername(username), checkPage(page)); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
+	q := pageQuery(page)
+	return fetchPaged[[]UserReview](ctx, s.c, userPath(username, "reviews"), q)
 }
 
-func (s *UserService) Recommendations(ctx context.Context, username string, page int) ([]struct {
-	MalID   string     `json:"mal_id"`
-	Entry   []Resource `json:"entry"`
-	Content string     `json:"content"`
-	Date    string     `json:"date"`
-}, *Pagination, error) {
-
-	q := url.Values{"page": {strconv.Itoa(page)}}
-	var r struct {
-		Data []struct {
-			MalID   string     `json:"mal_id"`
-			Entry   []Resource `json:"entry"`
-			Content string     `json:"content"`
-			Date    string     `json:"date"`
-		} `json:"data"`
-		Pagination Pagination `json:"pagination"`
+func (s *UserService) ReviewsAll(ctx context.Context, username string, pageOpts ...PageOption) iter.Seq2[UserReview, error] {
+	if err := checkUsername(username); err != nil {
+		return failed[UserReview](err)
 	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/users/%s/recommendations", username), q, &r); err != nil {
+	return paginate[UserReview](ctx, s.c, userPath(username, "reviews"), nil, pageOpts)
+}
+
+func (s *UserService) Recommendations(ctx context.Context, username string, page int) ([]Recommendation, *Pagination, error) {
+	if err := validate(checkUsername(username), checkPage(page)); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
+	q := pageQuery(page)
+	return fetchPaged[[]Recommendation](ctx, s.c, userPath(username, "recommendations"), q)
 }
 
-func (s *UserService) Clubs(ctx context.Context, username string, page int) ([]struct {
-	MalID ID     `json:"mal_id"`
-	Name  string `json:"name"`
-	URL   string `json:"url"`
-}, *Pagination, error) {
-
-	q := url.Values{"page": {strconv.Itoa(page)}}
-	var r struct {
-		Data []struct {
-			MalID ID     `json:"mal_id"`
-			Name  string `json:"name"`
-			URL   string `json:"url"`
-		} `json:"data"`
-		Pagination Pagination `json:"pagination"`
-	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/users/%s/clubs", username), q, &r); err != nil {
+func (s *UserService) RecommendationsAll(ctx context.Context, username string, pageOpts ...PageOption) iter.Seq2[Recommendation, error] {
+	if err := checkUsername(username); err != nil {
+		return failed[Recommendation](err)
+	}
+	return paginate[Recommendation](ctx, s.c, userPath(username, "recommendations"), nil, pageOpts)
+}
+
+func (s *UserService) Clubs(ctx context.Context, username string, page int) ([]UserClub, *Pagination, error) {
+	if err := validate(checkUsername(username), checkPage(page)); err != nil {
 		return nil, nil, err
 	}
-	return r.Data, &r.Pagination, nil
+	q := pageQuery(page)
+	return fetchPaged[[]UserClub](ctx, s.c, userPath(username, "clubs"), q)
 }
 
-func (s *UserService) External(ctx context.Context, username string) ([]struct {
-	Name string `json:"name"`
-	URL  string `json:"url"`
-}, error) {
-	var r struct {
-		Data []struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"data"`
+func (s *UserService) ClubsAll(ctx context.Context, username string, pageOpts ...PageOption) iter.Seq2[UserClub, error] {
+	if err := checkUsername(username); err != nil {
+		return failed[UserClub](err)
 	}
-	if err := s.c.Do(ctx, http.MethodGet, fmt.Sprintf("/users/%s/external", username), nil, &r); err != nil {
+	return paginate[UserClub](ctx, s.c, userPath(username, "clubs"), nil, pageOpts)
+}
+
+func (s *UserService) External(ctx context.Context, username string) ([]ExternalLink, error) {
+	if err := checkUsername(username); err != nil {
 		return nil, err
 	}
-	return r.Data, nil
+	return fetch[[]ExternalLink](ctx, s.c, userPath(username, "external"), nil)
 }
@@ -0,0 +1,146 @@
+package jikan
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxLimit is the largest page size Jikan accepts.
+const maxLimit = 25
+
+// ValidationError reports an argument rejected before any request is
+// made. It matches ErrInvalidArgument with errors.Is.
+type ValidationError struct {
+	Field  string
+	Value  any
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("jikan: invalid %s %v: %s", e.Field, e.Value, e.Reason)
+}
+
+func (e *ValidationError) Is(target error) bool { return target == ErrInvalidArgument }
+
+func invalid(field string, value any, reason string) *ValidationError {
+	return &ValidationError{Field: field, Value: value, Reason: reason}
+}
+
+// validate returns the first non-nil error. Service methods list every
+// check in one call so each argument is covered.
+func validate(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkID(field string, id ID) error {
+	if id < 1 {
+		return invalid(field, id, "must be positive")
+	}
+	return nil
+}
+
+// checkPage accepts zero, meaning the API default of page 1.
+func checkPage(page int) error {
+	if page < 0 {
+		return invalid("page", page, "must be 1 or more")
+	}
+	return nil
+}
+
+// checkLimit accepts zero, meaning the API default page size.
+func checkLimit(limit int) error {
+	if limit < 0 || limit > maxLimit {
+		return invalid("limit", limit, fmt.Sprintf("must be between 1 and %d", maxLimit))
+	}
+	return nil
+}
+
+// checkEnum accepts the empty value, which leaves the parameter unset.
+func checkEnum[T ~string](field string, v T, allowed ...T) error {
+	if v == "" || slices.Contains(allowed, v) {
+		return nil
+	}
+	return invalid(field, string(v), "unknown value")
+}
+
+// checkKnown is checkEnum for the types in enums.go, which know their own
+// values.
+func checkKnown[T interface {
+	~string
+	Known() bool
+}](field string, v T) error {
+	if v == "" || v.Known() {
+		return nil
+	}
+	return invalid(field, string(v), "unknown value")
+}
+
+// checkLetter accepts the single character Jikan's letter filters take.
+func checkLetter(s string) error {
+	if utf8.RuneCountInString(s) > 1 {
+		return invalid("letter", strconv.Quote(s), "must be a single character")
+	}
+	return nil
+}
+
+var queryDate = regexp.MustCompile(`^\d{4}(-\d{2}(-\d{2})?)?$`)
+
+// checkDate accepts the YYYY, YYYY-MM and YYYY-MM-DD forms Jikan takes
+// for date filters.
+func checkDate(field, s string) error {
+	if s == "" {
+		return nil
+	}
+	if !queryDate.MatchString(s) {
+		return invalid(field, s, "must be YYYY, YYYY-MM or YYYY-MM-DD")
+	}
+	if !ParseDate(s).Valid() {
+		return invalid(field, s, "not a calendar date")
+	}
+	return nil
+}
+
+var malUsername = regexp.MustCompile(`^[A-Za-z0-9_-]{2,16}$`)
+
+// checkUsername accepts the names MyAnimeList allows: 2 to 16 letters,
+// digits, underscores and dashes. Anything else, such as "..", could not
+// be a user and might resolve to a different route.
+func checkUsername(username string) error {
+	switch {
+	case strings.TrimSpace(username) == "":
+		return invalid("username", strconv.Quote(username), "must not be empty")
+	case !malUsername.MatchString(username):
+		return invalid("username", strconv.Quote(username), "must be 2 to 16 letters, digits, underscores or dashes")
+	}
+	return nil
+}
+
+// userPath builds a /users path. Callers check the name with
+// checkUsername first; the escaping only guards the path syntax.
+func userPath(username string, sub ...string) string {
+	p := "/users/" + url.PathEscape(username)
+	for _, s := range sub {
+		p += "/" + s
+	}
+	return p
+}
+
+// pageQuery returns the query for a page number, leaving it unset for the
+// default.
+func pageQuery(page int) url.Values {
+	q := url.Values{}
+	if page > 0 {
+		q.Set("page", strconv.Itoa(page))
+	}
+	return q
+}
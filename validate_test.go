@@ -0,0 +1,109 @@
+package jikan_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Sethispr/jikanGo"
+	"github.com/Sethispr/jikanGo/jikantest"
+)
+
+func TestSearchPageQuery(t *testing.T) {
+	c, srv := jikantest.NewClient(t)
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		call func() error
+		want string
+	}{
+		{"club first page", func() error { _, _, err := c.Club.Search(ctx, "bebop", 0); return err }, "/v4/clubs?q=bebop"},
+		{"club page", func() error { _, _, err := c.Club.Search(ctx, "bebop", 2); return err }, "/v4/clubs?page=2&q=bebop"},
+		{"character first page", func() error { _, _, err := c.Character.Search(ctx, "spike", 0); return err }, "/v4/characters?q=spike"},
+		{"character page", func() error { _, _, err := c.Character.Search(ctx, "spike", 3); return err }, "/v4/characters?page=3&q=spike"},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != nil {
+				t.Fatal(err)
+			}
+			if got := srv.Requests()[i]; got != tt.want {
+				t.Errorf("requested %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserSearchAges(t *testing.T) {
+	c, srv := jikantest.NewClient(t)
+	tests := []struct {
+		name        string
+		min, max    int
+		field, want string
+	}{
+		{"negative min", -1, 0, "minAge", "must not be negative"},
+		{"negative max", 0, -1, "maxAge", "must not be negative"},
+		{"max below min", 30, 20, "maxAge", "must not be below minAge"},
+		{"max unset", 30, 0, "", ""},
+		{"range", 20, 30, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := c.User.Search(context.Background(), jikan.UserSearchOptions{MinAge: tt.min, MaxAge: tt.max})
+			if tt.field == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			var ve *jikan.ValidationError
+			if !errors.As(err, &ve) || ve.Field != tt.field || ve.Reason != tt.want {
+				t.Fatalf("got %v, want %s %s", err, tt.field, tt.want)
+			}
+		})
+	}
+	if n := len(srv.Requests()); n != 2 {
+		t.Errorf("made %d requests, want 2", n)
+	}
+}
+
+func TestUsername(t *testing.T) {
+	c, srv := jikantest.NewClient(t)
+	srv.AddUser(jikan.UserFull{User: jikan.User{Username: "nekomata1037"}})
+	tests := []struct {
+		name, username, want string
+	}{
+		{"empty", "", "must not be empty"},
+		{"blank", "  ", "must not be empty"},
+		{"dot", ".", "must be 2 to 16 letters, digits, underscores or dashes"},
+		{"dot dot", "..", "must be 2 to 16 letters, digits, underscores or dashes"},
+		{"slash", "a/b", "must be 2 to 16 letters, digits, underscores or dashes"},
+		{"space", "spike spiegel", "must be 2 to 16 letters, digits, underscores or dashes"},
+		{"query", "abc?x=1", "must be 2 to 16 letters, digits, underscores or dashes"},
+		{"too short", "a", "must be 2 to 16 letters, digits, underscores or dashes"},
+		{"too long", "abcdefghijklmnopq", "must be 2 to 16 letters, digits, underscores or dashes"},
+		{"non-ASCII", "スパイク", "must be 2 to 16 letters, digits, underscores or dashes"},
+		{"valid", "nekomata1037", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.User.ByID(context.Background(), tt.username)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			var ve *jikan.ValidationError
+			if !errors.As(err, &ve) || ve.Field != "username" || ve.Reason != tt.want {
+				t.Fatalf("got %v, want username %s", err, tt.want)
+			}
+			if !errors.Is(err, jikan.ErrInvalidArgument) {
+				t.Errorf("errors.Is(%v, ErrInvalidArgument) = false", err)
+			}
+		})
+	}
+	if reqs := srv.Requests(); len(reqs) != 1 || reqs[0] != "/v4/users/nekomata1037" {
+		t.Errorf("requests = %v, want only the valid name", reqs)
+	}
+}
@@ -3,9 +3,8 @@ package jikan
 
 import (
 	"context"
+	"iter"
 	"net/http"
-	"net/url"
-	"strconv"
 )
 
 type WatchService struct {
@@ -13,17 +12,23 @@ type WatchService struct {
 }
 
 type EpisodePreview struct {
-	Entry    Resource `json:"entry"`
-	Episodes []struct {
-		MalID   ID     `json:"mal_id"`
-		Title   string `json:"title"`
-		Premium bool   `json:"premium"`
-	} `json:"episodes"`
-	RegionLocked bool `json:"region_locked"`
+	Entry        Entry          `json:"entry"`
+	Episodes     []WatchEpisode `json:"episodes"`
+	RegionLocked bool           `json:"region_locked"`
+}
+
+type WatchEpisode struct {
+	MalID   ID     `json:"mal_id"`
+	URL     string `json:"url"`
+	Title   string `json:"title"`
+	Premium bool   `json:"premium"`
 }
 
 func (s *WatchService) Episodes(ctx context.Context, page int) ([]EpisodePreview, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
+	if err := checkPage(page); err != nil {
+		return nil, nil, err
+	}
+	q := pageQuery(page)
 	var r struct {
 		Data       []EpisodePreview `json:"data"`
 		Pagination Pagination       `json:"pagination"`
@@ -34,18 +39,21 @@ func (s *WatchService) Episodes(ctx context.Context, page int) ([]EpisodePreview
 	return r.Data, &r.Pagination, nil
 }
 
+func (s *WatchService) EpisodesAll(ctx context.Context, pageOpts ...PageOption) iter.Seq2[EpisodePreview, error] {
+	return paginate[EpisodePreview](ctx, s.c, "/watch/episodes", nil, pageOpts)
+}
+
 type Promo struct {
-	Title   string `json:"title"`
-	Trailer struct {
-		YoutubeID string   `json:"youtube_id"`
-		EmbedURL  string   `json:"embed_url"`
-		Images    ImageSet `json:"images"`
-	} `json:"trailer"`
-	Entry Resource `json:"entry"`
+	Title   string  `json:"title"`
+	Trailer Trailer `json:"trailer"`
+	Entry   Entry   `json:"entry"`
 }
 
 func (s *WatchService) Promos(ctx context.Context, page int) ([]Promo, *Pagination, error) {
-	q := url.Values{"page": {strconv.Itoa(page)}}
+	if err := checkPage(page); err != nil {
+		return nil, nil, err
+	}
+	q := pageQuery(page)
 	var r struct {
 		Data       []Promo    `json:"data"`
 		Pagination Pagination `json:"pagination"`
@@ -55,3 +63,7 @@ func (s *WatchService) Promos(ctx context.Context, page int) ([]Promo, *Paginati
 	}
 	return r.Data, &r.Pagination, nil
 }
+
+func (s *WatchService) PromosAll(ctx context.Context, pageOpts ...PageOption) iter.Seq2[Promo, error] {
+	return paginate[Promo](ctx, s.c, "/watch/promos", nil, pageOpts)
+}